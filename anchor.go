@@ -0,0 +1,124 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	// "två timmar efter lunch", "en dag före julafton", "the monday after easter"
+	anchorRegex = regexp.MustCompile(` (före|innan|efter|before|after) `)
+
+	// "tre dagar", "en timme", "dagen", "2 arbetsdagar"
+	anchorOffsetRegex = regexp.MustCompile(`^(?:([\pL\d]+) )?(\pL+)$`)
+
+	anchorUnits = map[string]string{
+		// swe
+		"minut": "minute", "minuter": "minute",
+		"timme": "hour", "timmar": "hour",
+		"dag": "day", "dagar": "day", "dagen": "day",
+		"vecka": "week", "veckor": "week",
+		"månad": "month", "månader": "month",
+		"år":        "year",
+		"arbetsdag": "business day", "arbetsdagar": "business day",
+		"vardag": "business day", "vardagar": "business day",
+		// eng
+		"minute": "minute", "minutes": "minute",
+		"hour": "hour", "hours": "hour",
+		"day": "day", "days": "day",
+		"week": "week", "weeks": "week",
+		"month": "month", "months": "month",
+		"year": "year", "years": "year",
+	}
+
+	businessDayReplacer = strings.NewReplacer("business days", "arbetsdagar", "business day", "arbetsdag", "working days", "arbetsdagar", "working day", "arbetsdag")
+)
+
+// parseAnchored parses an offset or a weekday relative to an anchor, as in "två
+// timmar efter lunch", "tre arbetsdagar innan 3 maj", "fredagen före midsommar" or
+// "the monday after easter". The anchor is anything ParseTime understands, or
+// a name in the parser Anchors. Each före, innan, efter, before or after is tried
+// in turn to split the expression, so the anchor may itself be anchored. It
+// returns true if s has an offset, even if the anchor cannot be parsed
+func (p *Parser) parseAnchored(s string) (time.Time, bool, error) {
+	s = strings.ToLower(s)
+	err := fmt.Errorf("Cannot parse anchored time: %s", s)
+	found := false
+	for _, idx := range anchorRegex.FindAllStringSubmatchIndex(s, -1) {
+		offset, op, name := s[:idx[0]], s[idx[2]:idx[3]], s[idx[1]:]
+		wd, wdErr := ParseWeekday(strings.TrimPrefix(offset, "the "))
+		n, unit, offsetErr := parseAnchorOffset(offset)
+		if wdErr != nil && offsetErr != nil {
+			continue
+		}
+		found = true
+
+		anchor, ok := p.Anchors[name]
+		if !ok {
+			if anchor, err = p.ParseTime(name); err != nil {
+				continue
+			}
+		}
+		sign := 1
+		if op != "efter" && op != "after" {
+			sign = -1
+		}
+
+		// "måndagen efter påsk", "the friday before christmas"
+		if wdErr == nil {
+			diff := (int(wd) - int(anchor.Weekday()) + 7) % 7
+			if sign < 0 {
+				diff = (int(anchor.Weekday()) - int(wd) + 7) % 7
+			}
+			if diff == 0 {
+				diff = 7
+			}
+			return addDay(anchor, sign*diff), true, nil
+		}
+
+		n *= sign
+		switch unit {
+		case "minute":
+			return anchor.Add(time.Duration(n) * time.Minute), true, nil
+		case "hour":
+			return anchor.Add(time.Duration(n) * time.Hour), true, nil
+		case "day":
+			return anchor.AddDate(0, 0, n), true, nil
+		case "week":
+			return anchor.AddDate(0, 0, 7*n), true, nil
+		case "month":
+			return anchor.AddDate(0, n, 0), true, nil
+		case "year":
+			return anchor.AddDate(n, 0, 0), true, nil
+		}
+		return AddBusinessDays(anchor, n, p.calendar()), true, nil
+	}
+	return time.Time{}, found, err
+}
+
+// parseAnchorOffset parses an offset like "tre dagar", "en timme", "dagen",
+// "2 business days" or "the day" into a count and a unit
+func parseAnchorOffset(s string) (int, string, error) {
+	s = strings.TrimPrefix(s, "the ")
+	s = businessDayReplacer.Replace(s)
+
+	match := anchorOffsetRegex.FindStringSubmatch(s)
+	if match == nil {
+		return 0, "", fmt.Errorf("Cannot parse offset: %s", s)
+	}
+	unit, ok := anchorUnits[match[2]]
+	if !ok {
+		return 0, "", fmt.Errorf("Cannot parse offset: %s", s)
+	}
+	switch match[1] {
+	case "", "en", "ett", "a", "an", "one":
+		return 1, unit, nil
+	}
+	n, err := ParseNumber(match[1])
+	if err != nil {
+		return 0, "", err
+	}
+	return int(n.IntPart()), unit, nil
+}
@@ -0,0 +1,110 @@
+package natural
+
+import (
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeAnchored(t *testing.T) {
+	// the test parser is at wednesday 2024-04-17 15:30
+	expected := map[string]string{
+		// swe
+		"två timmar efter lunch":             "2024-04-17 14:00",
+		"en timme före kl 15":                "2024-04-17 14:00",
+		"en dag före julafton":               "2024-12-23 00:00",
+		"dagen efter julafton":               "2024-12-25 00:00",
+		"två veckor efter 3 maj":             "2024-05-17 00:00",
+		"måndagen efter påsk":                "2024-04-01 00:00",
+		"fredagen före midsommarafton":       "2024-06-14 00:00",
+		"en månad innan 31 december":         "2024-12-01 00:00",
+		"en dag före dagen efter julafton":   "2024-12-24 00:00",
+		"fem minuter efter tre":              "2024-04-17 03:05",
+		"två dagar efter fredagen före påsk": "2024-03-31 00:00",
+		// eng
+		"the Monday after Easter":  "2024-04-01 00:00",
+		"tuesday before easter":    "2024-03-26 00:00",
+		"the day before christmas": "2024-12-24 00:00",
+		"three days after may 3":   "2024-05-06 00:00",
+	}
+	p := testParser(LocaleSvSE)
+	for in, exp := range expected {
+		res, err := p.ParseTime(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, res.Format("2006-01-02 15:04"), in)
+	}
+
+	// other rules using efter are unchanged
+	res, err := p.ParseTime("strax efter tre")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-04-17 03:00", res.Format("2006-01-02 15:04"))
+
+	_, err = p.ParseTime("tre dagar efter ingenting")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestParseTimeNamedAnchor(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	p.Anchors = map[string]time.Time{
+		// friday, the day after ascension day
+		"deadline": time.Date(2024, time.May, 10, 17, 0, 0, 0, time.UTC),
+	}
+	expected := map[string]string{
+		"tre dagar innan deadline":       "2024-05-07 17:00",
+		"tre arbetsdagar innan deadline": "2024-05-06 17:00",
+		"2 business days after deadline": "2024-05-14 17:00",
+		"en timme efter deadline":        "2024-05-10 18:00",
+		"måndagen efter deadline":        "2024-05-13 00:00",
+	}
+	for in, exp := range expected {
+		res, err := p.ParseTime(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, res.Format("2006-01-02 15:04"), in)
+	}
+}
+
+func TestParseAnchorOffset(t *testing.T) {
+	expected := map[string]struct {
+		n    int
+		unit string
+	}{
+		"tre dagar":       {3, "day"},
+		"en timme":        {1, "hour"},
+		"dagen":           {1, "day"},
+		"the day":         {1, "day"},
+		"2 business days": {2, "business day"},
+		"a working day":   {1, "business day"},
+		"fyra veckor":     {4, "week"},
+	}
+	for in, exp := range expected {
+		n, unit, err := parseAnchorOffset(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp.n, n, in)
+		assert.Equal(t, exp.unit, unit, in)
+	}
+
+	_, _, err := parseAnchorOffset("tre äpplen")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestParseTimeAnchoredFails(t *testing.T) {
+	p := testParser(LocaleSvSE)
+
+	// failing input is returned as an error, nothing is printed
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	assert.Equal(t, nil, err)
+	os.Stdout = w
+	for _, in := range []string{"en vecka efter nästa fredag", "tre dagar efter ingenting", "blå dagar efter jul", "två veckor efter tre blå"} {
+		_, err := p.ParseTime(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+	w.Close()
+	os.Stdout = stdout
+	out, err := io.ReadAll(r)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", string(out))
+}
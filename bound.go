@@ -0,0 +1,144 @@
+package natural
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Bound is an open ended interval, such as "senast fredag" or "from next week".
+// The interval is half open, with a zero Start or End on the open side
+type Bound struct {
+	Interval
+	// Inclusive is true if the anchor is within the bound, as in "senast fredag"
+	// or "från och med måndag", and false as in "före fredag" or "after monday"
+	Inclusive bool
+}
+
+var (
+	// bound markers, tried in order
+	boundMarkers = []struct {
+		prefix    string
+		upper     bool
+		inclusive bool
+	}{
+		// swe
+		{"senast ", true, true},
+		{"till och med ", true, true},
+		{"t.o.m. ", true, true},
+		{"innan ", true, false},
+		{"före ", true, false},
+		{"från och med ", false, true},
+		{"fr.o.m. ", false, true},
+		{"från ", false, true},
+		{"efter ", false, false},
+		// eng
+		{"no later than ", true, true},
+		{"not later than ", true, true},
+		{"by ", true, true},
+		{"before ", true, false},
+		{"prior to ", true, false},
+		{"starting from ", false, true},
+		{"starting ", false, true},
+		{"from ", false, true},
+		{"as of ", false, true},
+		{"after ", false, false},
+	}
+
+	// the end of today, "by end of day", "före midnatt"
+	endOfDayNames = []string{"dagens slut", "slutet av dagen", "end of day", "end of the day", "eod", "midnatt", "midnight"}
+)
+
+// ParseBound parses a bound relative to the current time, see Parser.ParseBound
+func ParseBound(s string) (Bound, error) {
+	return NewParser(LocaleSvSE).ParseBound(s)
+}
+
+// ParseBound parses deadlines and open ended bounds like "senast fredag", "innan
+// månadsskiftet", "från och med 3 maj", "efter kl 17", "by end of day", "no later
+// than friday" or "from next week". The anchor is a time of day, a day or a period,
+// see ParseRange. Inclusive bounds ("senast", "från och med", "by", "from") include
+// the whole anchor, exclusive bounds ("innan", "efter", "before", "after") exclude it.
+// Times of day are anchors one minute long, so "senast kl 17" ends at 17:01, and day
+// parts are their configured hours, so "efter lunch" starts at 13:00
+func (p *Parser) ParseBound(s string) (Bound, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for _, m := range boundMarkers {
+		if !strings.HasPrefix(s, m.prefix) {
+			continue
+		}
+		anchor, err := p.parseBoundAnchor(strings.TrimSpace(s[len(m.prefix):]))
+		if err != nil {
+			return Bound{}, fmt.Errorf("Cannot parse bound: %s: %s", s, err)
+		}
+		b := Bound{Inclusive: m.inclusive}
+		switch {
+		case m.upper && m.inclusive:
+			b.End = anchor.End
+		case m.upper:
+			b.End = anchor.Start
+		case m.inclusive:
+			b.Start = anchor.Start
+		default:
+			b.Start = anchor.End
+		}
+		return b, nil
+	}
+	return Bound{}, fmt.Errorf("Cannot parse bound: %s", s)
+}
+
+// parseBoundAnchor parses the time, day or period a bound is relative to
+func (p *Parser) parseBoundAnchor(s string) (Interval, error) {
+	now := p.now()
+	for _, name := range endOfDayNames {
+		if s == name {
+			end := addDay(now, 1)
+			return Interval{Start: end, End: end}, nil
+		}
+	}
+
+	// "månadsskiftet" is the turn itself
+	if periodTurnRegex.MatchString(s) {
+		i, err := p.ParsePeriod(s)
+		if err != nil {
+			return i, err
+		}
+		turn := addDay(i.Start, 3)
+		return Interval{Start: turn, End: turn}, nil
+	}
+
+	// "innan jul" is christmas, not july
+	if h, err := p.ParseHoliday(s); err == nil {
+		return dayInterval(h.Date, 1), nil
+	}
+
+	// "före lunch", "after the evening"
+	if i, err := p.ParseDayPart(s); err == nil {
+		return i, nil
+	}
+
+	ep, err := p.parseRangeEndpoint(s)
+	if err != nil {
+		return Interval{}, err
+	}
+	if ep.clock {
+		return Interval{Start: ep.Start, End: ep.Start.Add(time.Minute)}, nil
+	}
+	return ep.Interval, nil
+}
+
+// Contains reports whether t is within the bound
+func (b Bound) Contains(t time.Time) bool {
+	return (b.Start.IsZero() || !t.Before(b.Start)) && (b.End.IsZero() || t.Before(b.End))
+}
+
+// formats as "- 2024-04-20 00:00" or "2024-04-22 00:00 -"
+func (b Bound) String() string {
+	if b.Start.IsZero() {
+		return "- " + b.End.Format("2006-01-02 15:04")
+	}
+	if b.End.IsZero() {
+		return b.Start.Format("2006-01-02 15:04") + " -"
+	}
+	return b.Interval.String()
+}
@@ -0,0 +1,77 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBound(t *testing.T) {
+	// the test parser is at wednesday 2024-04-17 15:30
+	expected := map[string]struct {
+		bound     string
+		inclusive bool
+	}{
+		// swe
+		"senast fredag":              {"- 2024-04-20 00:00", true},
+		"före fredag":                {"- 2024-04-19 00:00", false},
+		"innan månadsskiftet":        {"- 2024-05-01 00:00", false},
+		"innan årsskiftet":           {"- 2025-01-01 00:00", false},
+		"till och med 5 maj":         {"- 2024-05-06 00:00", true},
+		"senast kl 17":               {"- 2024-04-17 17:01", true},
+		"senast imorgon kl 12":       {"- 2024-04-18 12:01", true},
+		"senast i slutet av månaden": {"- 2024-05-01 00:00", true},
+		"från och med 3 maj":         {"2024-05-03 00:00 -", true},
+		"från nästa vecka":           {"2024-04-22 00:00 -", true},
+		"efter kl 17":                {"2024-04-17 17:01 -", false},
+		"efter påsk":                 {"2024-04-01 00:00 -", false},
+		"innan jul":                  {"- 2024-12-24 00:00", false},
+		"efter jul":                  {"2024-12-25 00:00 -", false},
+		"före juli":                  {"- 2024-07-01 00:00", false},
+		"före lunch":                 {"- 2024-04-17 11:00", false},
+		"efter lunch":                {"2024-04-17 13:00 -", false},
+		"före midnatt":               {"- 2024-04-18 00:00", false},
+		"innan kvart i fem":          {"- 2024-04-17 04:45", false},
+		"senast halv fem":            {"- 2024-04-17 04:31", true},
+		// eng
+		"by end of day":             {"- 2024-04-18 00:00", true},
+		"no later than friday":      {"- 2024-04-20 00:00", true},
+		"before christmas":          {"- 2024-12-25 00:00", false},
+		"from next week":            {"2024-04-22 00:00 -", true},
+		"starting tomorrow":         {"2024-04-18 00:00 -", true},
+		"after monday":              {"2024-04-23 00:00 -", false},
+		"before noon":               {"- 2024-04-17 12:00", false},
+		"after noon":                {"2024-04-17 12:01 -", false},
+		"before six in the evening": {"- 2024-04-17 18:00", false},
+		"before midnight":           {"- 2024-04-18 00:00", false},
+	}
+	p := testParser(LocaleSvSE)
+	for in, exp := range expected {
+		b, err := p.ParseBound(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp.bound, b.String(), in)
+		assert.Equal(t, exp.inclusive, b.Inclusive, in)
+	}
+
+	for _, in := range []string{"", "fredag", "senast", "innan ingenting"} {
+		_, err := p.ParseBound(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
+
+func TestBoundContains(t *testing.T) {
+	p := testParser(LocaleSvSE)
+
+	b, err := p.ParseBound("senast fredag")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, b.Contains(time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, true, b.Contains(time.Date(2024, time.April, 19, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, false, b.Contains(time.Date(2024, time.April, 20, 0, 0, 0, 0, time.UTC)))
+
+	b, err = p.ParseBound("efter fredag")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, b.Contains(time.Date(2024, time.April, 19, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, true, b.Contains(time.Date(2024, time.April, 20, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, true, b.Contains(time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)))
+}
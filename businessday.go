@@ -0,0 +1,141 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Calendar decides which days are business days
+type Calendar interface {
+	IsBusinessDay(t time.Time) bool
+}
+
+// HolidayCalendar is a Calendar where weekends and the holidays of Country
+// are days off. Half days, such as trettondagsafton, are working days unless
+// HalfDaysOff is set
+type HolidayCalendar struct {
+	Country string
+	// HalfDaysOff makes half days like trettondagsafton days off
+	HalfDaysOff bool
+	// BridgeDaysOff makes klämdagar days off, a single working day between a day off and a weekend
+	BridgeDaysOff bool
+	// DaysOff are additional days off, such as company holidays
+	DaysOff []MonthDay
+}
+
+var (
+	// "om tre arbetsdagar", "inom två vardagar", "within 5 business days"
+	businessDaysRegex = regexp.MustCompile(`^(?:om|inom|efter|in|within|after) (.+) (?:arbetsdagar|arbetsdag|vardagar|vardag|bankdagar|bankdag|business days|business day|working days|working day)$`)
+
+	// "nästa vardag", "next working day"
+	nextBusinessDayRegex = regexp.MustCompile(`^(nästa|kommande|föregående|förra|next|previous|last) (?:arbetsdag|vardag|bankdag|business day|working day)$`)
+)
+
+// NewHolidayCalendar returns a Calendar with the holidays of country
+func NewHolidayCalendar(country string) *HolidayCalendar {
+	return &HolidayCalendar{Country: country}
+}
+
+// IsBusinessDay reports whether t is a working day
+func (c *HolidayCalendar) IsBusinessDay(t time.Time) bool {
+	if c.isDayOff(t) {
+		return false
+	}
+	if c.BridgeDaysOff && c.isDayOff(addDay(t, -1)) && c.isDayOff(addDay(t, 1)) {
+		// klämdag
+		return false
+	}
+	return true
+}
+
+func (c *HolidayCalendar) isDayOff(t time.Time) bool {
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return true
+	}
+	for _, md := range c.DaysOff {
+		if md.Month == t.Month() && md.Day == int64(t.Day()) {
+			return true
+		}
+	}
+	day := beginningOfDay(t)
+	for _, h := range holidays(t.Year(), c.Country, t.Location()) {
+		if !h.Date.Equal(day) {
+			continue
+		}
+		switch h.Kind {
+		case HolidayPublic, HolidayDayOff:
+			return true
+		case HolidayHalfDay:
+			if c.HalfDaysOff {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AddBusinessDays returns t moved n business days in cal, keeping the time of day.
+// Negative n moves backwards
+func AddBusinessDays(t time.Time, n int, cal Calendar) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if cal.IsBusinessDay(t) {
+			n--
+		}
+	}
+	return t
+}
+
+// NextBusinessDay returns midnight of the first business day after t
+func NextBusinessDay(t time.Time, cal Calendar) time.Time {
+	return beginningOfDay(AddBusinessDays(t, 1, cal))
+}
+
+func (p *Parser) calendar() Calendar {
+	if p.Calendar == nil {
+		return NewHolidayCalendar(localeCountry(p.Locale))
+	}
+	return p.Calendar
+}
+
+// ParseBusinessDays parses business day expressions relative to the current
+// time, see Parser.ParseBusinessDays
+func ParseBusinessDays(s string) (time.Time, error) {
+	return NewParser(LocaleSvSE).ParseBusinessDays(s)
+}
+
+// ParseBusinessDays parses expressions like "om tre arbetsdagar", "inom två
+// vardagar", "nästa vardag", "within 5 business days" or "next working day",
+// counting business days in the parser calendar from the reference time
+func (p *Parser) ParseBusinessDays(s string) (time.Time, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	now := p.now()
+
+	if match := nextBusinessDayRegex.FindStringSubmatch(s); match != nil {
+		switch match[1] {
+		case "föregående", "förra", "previous", "last":
+			return beginningOfDay(AddBusinessDays(now, -1, p.calendar())), nil
+		}
+		return NextBusinessDay(now, p.calendar()), nil
+	}
+
+	if match := businessDaysRegex.FindStringSubmatch(s); match != nil {
+		num := match[1]
+		if num == "a" || num == "en" {
+			num = "1"
+		}
+		n, err := ParseNumber(num)
+		if err != nil {
+			return now, err
+		}
+		return AddBusinessDays(now, int(n.IntPart()), p.calendar()), nil
+	}
+
+	return now, fmt.Errorf("Cannot parse business days: %s", s)
+}
@@ -0,0 +1,72 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddBusinessDays(t *testing.T) {
+	cal := NewHolidayCalendar(CountrySE)
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 15, 30, 0, 0, time.UTC)
+	}
+
+	assert.Equal(t, day(2024, time.April, 22), AddBusinessDays(day(2024, time.April, 17), 3, cal))
+	assert.Equal(t, day(2024, time.April, 12), AddBusinessDays(day(2024, time.April, 17), -3, cal))
+
+	// påsk: skärtorsdagen is a working day, långfredagen and annandag påsk are not
+	assert.Equal(t, day(2024, time.March, 28), AddBusinessDays(day(2024, time.March, 27), 1, cal))
+	assert.Equal(t, day(2024, time.April, 2), AddBusinessDays(day(2024, time.March, 27), 2, cal))
+
+	// midsommarafton
+	assert.Equal(t, day(2024, time.June, 24), AddBusinessDays(day(2024, time.June, 20), 1, cal))
+
+	// klämdag after Kristi himmelsfärdsdag
+	assert.Equal(t, day(2024, time.May, 10), AddBusinessDays(day(2024, time.May, 8), 1, cal))
+	bridge := &HolidayCalendar{Country: CountrySE, BridgeDaysOff: true}
+	assert.Equal(t, day(2024, time.May, 13), AddBusinessDays(day(2024, time.May, 8), 1, bridge))
+
+	// trettondagsafton
+	assert.Equal(t, day(2023, time.January, 5), AddBusinessDays(day(2023, time.January, 4), 1, cal))
+	halfDays := &HolidayCalendar{Country: CountrySE, HalfDaysOff: true}
+	assert.Equal(t, day(2023, time.January, 9), AddBusinessDays(day(2023, time.January, 4), 1, halfDays))
+
+	extra := &HolidayCalendar{Country: CountrySE, DaysOff: []MonthDay{NewMonthDay("04-18")}}
+	assert.Equal(t, day(2024, time.April, 19), AddBusinessDays(day(2024, time.April, 17), 1, extra))
+
+	us := NewHolidayCalendar(CountryUS)
+	assert.Equal(t, day(2024, time.November, 29), AddBusinessDays(day(2024, time.November, 27), 1, us))
+
+	assert.Equal(t, "2024-04-18 00:00", NextBusinessDay(day(2024, time.April, 17), cal).Format("2006-01-02 15:04"))
+}
+
+func TestParseBusinessDays(t *testing.T) {
+	expected := map[string]string{
+		// swe
+		"om tre arbetsdagar":   "2024-04-22 15:30",
+		"inom två arbetsdagar": "2024-04-19 15:30",
+		"om en vardag":         "2024-04-18 15:30",
+		"nästa vardag":         "2024-04-18 00:00",
+		"föregående bankdag":   "2024-04-16 00:00",
+		// eng
+		"within 5 business days": "2024-04-24 15:30",
+		"in a working day":       "2024-04-18 15:30",
+		"next working day":       "2024-04-18 00:00",
+	}
+	p := testParser(LocaleSvSE)
+	for s, expect := range expected {
+		res, err := p.ParseBusinessDays(s)
+		assert.Equal(t, nil, err, s)
+		assert.Equal(t, expect, res.Format("2006-01-02 15:04"), s)
+	}
+
+	_, err := p.ParseBusinessDays("om tre veckor")
+	assert.NotEqual(t, nil, err)
+
+	p.Calendar = &HolidayCalendar{Country: CountrySE, DaysOff: []MonthDay{NewMonthDay("04-18")}}
+	res, err := p.ParseBusinessDays("nästa vardag")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-04-19", res.Format("2006-01-02"))
+}
@@ -0,0 +1,256 @@
+package natural
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ClockStyle selects how PresentClock renders a time of day
+type ClockStyle int
+
+// ClockExact renders the exact minute, "tre minuter över sju"
+const ClockExact ClockStyle = 0
+
+// Flags, combine with ClockExact or each other
+const (
+	// ClockRounded rounds to the nearest five minutes
+	ClockRounded ClockStyle = 1 << iota
+	// Clock12h appends the part of day, "tjugo i elva på kvällen"
+	Clock12h
+	// Clock24h reads the time as hours and minutes, "arton och trettio"
+	Clock24h
+	// ClockWithZone appends the time zone, "tre svensk tid", see PresentZone
+	ClockWithZone
+)
+
+var (
+	// qualifiers used by PresentApproximateClock, understood by ParseTime
+	approximateClockRegex = regexp.MustCompile(`^(?:strax efter|lite efter|strax före|lite före|nästan|ungefär|just after|a little after|a little before|almost|nearly|about) (?P<time>.+)$`)
+)
+
+// PresentClock returns the time of day in t as spoken in locale, such as
+// "fem i halv tre" or "twenty-five past two"
+func PresentClock(t time.Time, locale string, style ClockStyle) string {
+	if style&ClockWithZone != 0 {
+		return PresentClock(t, locale, style&^ClockWithZone) + " " + PresentZone(t, locale)
+	}
+	hour, minute := t.Hour(), t.Minute()
+	if style&ClockRounded != 0 {
+		hour, minute = roundClock(hour, minute, 5)
+	}
+
+	switch locale {
+	case LocaleSvSE:
+		if style&Clock24h != 0 {
+			return clock24SvSE(hour, minute)
+		}
+		if style&Clock12h != 0 {
+			if minute == 0 && hour == 0 {
+				return "midnatt"
+			}
+			if minute == 0 && hour == 12 {
+				return "middag"
+			}
+			return clockSvSE(hour, minute) + " " + dayPartSvSE(hour)
+		}
+		return clockSvSE(hour, minute)
+	}
+
+	if style&Clock24h != 0 {
+		return clock24EnUS(hour, minute)
+	}
+	if style&Clock12h != 0 {
+		if minute == 0 && hour == 0 {
+			return "midnight"
+		}
+		if minute == 0 && hour == 12 {
+			return "noon"
+		}
+		return clockEnUS(hour, minute) + " " + dayPartEnUS(hour)
+	}
+	return clockEnUS(hour, minute)
+}
+
+// PresentApproximateClock returns the time of day in t like a fuzzy clock,
+// such as "strax efter tre" or "almost quarter to seven". Times within
+// tolerance of a quarter hour are presented as that quarter hour
+func PresentApproximateClock(t time.Time, tolerance time.Duration, locale string) string {
+	offset := time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+	anchor := (offset + 15*time.Minute/2) / (15 * time.Minute) * (15 * time.Minute)
+	diff := offset - anchor
+
+	hour := int(anchor/time.Hour) % 24
+	minute := int(anchor%time.Hour) / int(time.Minute)
+
+	switch locale {
+	case LocaleSvSE:
+		s := clockSvSE(hour, minute)
+		switch {
+		case diff > tolerance && diff <= 5*time.Minute:
+			return "strax efter " + s
+		case diff > tolerance:
+			return "lite efter " + s
+		case -diff > tolerance && -diff <= 5*time.Minute:
+			return "nästan " + s
+		case -diff > tolerance:
+			return "lite före " + s
+		}
+		return s
+	}
+
+	s := clockEnUS(hour, minute)
+	if diff > tolerance || -diff > tolerance {
+		s = strings.TrimSuffix(s, " o'clock")
+	}
+	switch {
+	case diff > tolerance && diff <= 5*time.Minute:
+		return "just after " + s
+	case diff > tolerance:
+		return "a little after " + s
+	case -diff > tolerance && -diff <= 5*time.Minute:
+		return "almost " + s
+	case -diff > tolerance:
+		return "a little before " + s
+	}
+	return s
+}
+
+// roundClock rounds hour:minute to the nearest multiple of step minutes
+func roundClock(hour, minute, step int) (int, int) {
+	total := hour*60 + minute
+	total = (total + step/2) / step * step
+	total = total % (24 * 60)
+	return total / 60, total % 60
+}
+
+// hour12 maps 0-23 to the 1-12 reading used in speech
+func hour12(hour int) int64 {
+	hour = hour % 12
+	if hour == 0 {
+		return 12
+	}
+	return int64(hour)
+}
+
+// minutesSvSE returns "fem", "tre minuter" or "en minut"
+func minutesSvSE(n int) string {
+	if n == 1 {
+		return "en minut"
+	}
+	if n%5 != 0 {
+		return PresentSvSE(int64(n)) + " minuter"
+	}
+	return PresentSvSE(int64(n))
+}
+
+// minutesEnUS returns "five", "three minutes" or "one minute"
+func minutesEnUS(n int) string {
+	if n == 1 {
+		return "one minute"
+	}
+	if n%5 != 0 {
+		return PresentEnUS(int64(n)) + " minutes"
+	}
+	return PresentEnUS(int64(n))
+}
+
+// clockSvSE returns the Swedish reading of hour:minute, "fem i halv tre"
+func clockSvSE(hour, minute int) string {
+	this := PresentSvSE(hour12(hour))
+	next := PresentSvSE(hour12(hour + 1))
+
+	switch {
+	case minute == 0:
+		return this
+	case minute == 15:
+		return "kvart över " + this
+	case minute <= 20:
+		return minutesSvSE(minute) + " över " + this
+	case minute < 30:
+		return minutesSvSE(30-minute) + " i halv " + next
+	case minute == 30:
+		return "halv " + next
+	case minute < 40:
+		return minutesSvSE(minute-30) + " över halv " + next
+	case minute == 45:
+		return "kvart i " + next
+	}
+	return minutesSvSE(60-minute) + " i " + next
+}
+
+// clockEnUS returns the English reading of hour:minute, "twenty-five past two"
+func clockEnUS(hour, minute int) string {
+	this := PresentEnUS(hour12(hour))
+	next := PresentEnUS(hour12(hour + 1))
+
+	switch {
+	case minute == 0:
+		return this + " o'clock"
+	case minute == 15:
+		return "quarter past " + this
+	case minute == 30:
+		return "half past " + this
+	case minute < 30:
+		return minutesEnUS(minute) + " past " + this
+	case minute == 45:
+		return "quarter to " + next
+	}
+	return minutesEnUS(60-minute) + " to " + next
+}
+
+// clock24SvSE returns "arton och trettio"
+func clock24SvSE(hour, minute int) string {
+	s := "noll"
+	if hour > 0 {
+		s = PresentSvSE(int64(hour))
+	}
+	if minute == 0 {
+		return s
+	}
+	return s + " och " + PresentSvSE(int64(minute))
+}
+
+// clock24EnUS returns "eighteen thirty", "eighteen oh five" or "eighteen hundred"
+func clock24EnUS(hour, minute int) string {
+	s := "zero"
+	if hour > 0 {
+		s = PresentEnUS(int64(hour))
+	}
+	switch {
+	case minute == 0:
+		return s + " hundred"
+	case minute < 10:
+		return s + " oh " + PresentEnUS(int64(minute))
+	}
+	return s + " " + PresentEnUS(int64(minute))
+}
+
+// https://sv.wikipedia.org/wiki/Dygn
+func dayPartSvSE(hour int) string {
+	switch {
+	case hour < 5:
+		return "på natten"
+	case hour < 10:
+		return "på morgonen"
+	case hour < 12:
+		return "på förmiddagen"
+	case hour < 18:
+		return "på eftermiddagen"
+	}
+	return "på kvällen"
+}
+
+func dayPartEnUS(hour int) string {
+	switch {
+	case hour < 5:
+		return "at night"
+	case hour < 12:
+		return "in the morning"
+	case hour < 18:
+		return "in the afternoon"
+	}
+	return "in the evening"
+}
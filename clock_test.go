@@ -0,0 +1,132 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func clockAt(hour, minute int) time.Time {
+	return time.Date(2021, time.December, 13, hour, minute, 0, 0, time.UTC)
+}
+
+func TestPresentClockSV(t *testing.T) {
+	expected := map[string]time.Time{
+		// expected output, input
+		"sju":                    clockAt(7, 0),
+		"kvart över sju":         clockAt(7, 15),
+		"fem över sju":           clockAt(7, 5),
+		"tjugo över sju":         clockAt(7, 20),
+		"en minut över sju":      clockAt(7, 1),
+		"tre minuter över sju":   clockAt(7, 3),
+		"fem i halv tre":         clockAt(14, 25),
+		"tre minuter i halv tre": clockAt(2, 27),
+		"halv tre":               clockAt(2, 30),
+		"fem över halv tre":      clockAt(2, 35),
+		"tjugo i elva":           clockAt(22, 40),
+		"kvart i tolv":           clockAt(11, 45),
+		"fem i ett":              clockAt(0, 55),
+		"tio i tolv":             clockAt(23, 50),
+		"nitton minuter i tolv":  clockAt(11, 41),
+		"tolv minuter i åtta":    clockAt(7, 48),
+	}
+	for s, tm := range expected {
+		assert.Equal(t, s, PresentClock(tm, LocaleSvSE, ClockExact))
+	}
+}
+
+func TestPresentClockEN(t *testing.T) {
+	expected := map[string]time.Time{
+		// expected output, input
+		"seven o'clock":                 clockAt(7, 0),
+		"quarter past seven":            clockAt(7, 15),
+		"twenty-five past two":          clockAt(14, 25),
+		"half past two":                 clockAt(2, 30),
+		"twenty to eleven":              clockAt(22, 40),
+		"quarter to twelve":             clockAt(11, 45),
+		"one minute past seven":         clockAt(7, 1),
+		"twenty-three minutes to eight": clockAt(7, 37),
+	}
+	for s, tm := range expected {
+		assert.Equal(t, s, PresentClock(tm, LocaleEnUS, ClockExact))
+	}
+}
+
+func TestPresentClockStyles(t *testing.T) {
+	assert.Equal(t, "tjugo i elva på kvällen", PresentClock(clockAt(22, 40), LocaleSvSE, Clock12h))
+	assert.Equal(t, "kvart över sju på morgonen", PresentClock(clockAt(7, 15), LocaleSvSE, Clock12h))
+	assert.Equal(t, "middag", PresentClock(clockAt(12, 0), LocaleSvSE, Clock12h))
+	assert.Equal(t, "midnatt", PresentClock(clockAt(0, 0), LocaleSvSE, Clock12h))
+	assert.Equal(t, "twenty to eleven in the evening", PresentClock(clockAt(22, 40), LocaleEnUS, Clock12h))
+	assert.Equal(t, "noon", PresentClock(clockAt(12, 0), LocaleEnUS, Clock12h))
+
+	assert.Equal(t, "arton och trettio", PresentClock(clockAt(18, 30), LocaleSvSE, Clock24h))
+	assert.Equal(t, "arton", PresentClock(clockAt(18, 0), LocaleSvSE, Clock24h))
+	assert.Equal(t, "eighteen thirty", PresentClock(clockAt(18, 30), LocaleEnUS, Clock24h))
+	assert.Equal(t, "eighteen oh five", PresentClock(clockAt(18, 5), LocaleEnUS, Clock24h))
+	assert.Equal(t, "eighteen hundred", PresentClock(clockAt(18, 0), LocaleEnUS, Clock24h))
+
+	assert.Equal(t, "fem i halv tre", PresentClock(clockAt(14, 23), LocaleSvSE, ClockRounded))
+	assert.Equal(t, "åtta", PresentClock(clockAt(7, 58), LocaleSvSE, ClockRounded))
+	assert.Equal(t, "tolv", PresentClock(clockAt(23, 59), LocaleSvSE, ClockRounded))
+	assert.Equal(t, "twenty past seven in the morning", PresentClock(clockAt(7, 19), LocaleEnUS, ClockRounded|Clock12h))
+}
+
+func TestPresentApproximateClock(t *testing.T) {
+	tolerance := time.Minute
+	expectedSV := map[string]time.Time{
+		// expected output, input
+		"tre":                    clockAt(15, 1),
+		"strax efter tre":        clockAt(15, 3),
+		"lite efter tre":         clockAt(15, 7),
+		"nästan halv fyra":       clockAt(15, 27),
+		"lite före halv fyra":    clockAt(15, 23),
+		"lite efter kvart i sju": clockAt(18, 52),
+	}
+	for s, tm := range expectedSV {
+		assert.Equal(t, s, PresentApproximateClock(tm, tolerance, LocaleSvSE))
+	}
+
+	expectedEN := map[string]time.Time{
+		// expected output, input
+		"three o'clock":           clockAt(15, 0),
+		"just after three":        clockAt(15, 2),
+		"almost quarter to seven": clockAt(18, 42),
+		"a little before three":   clockAt(14, 53),
+	}
+	for s, tm := range expectedEN {
+		assert.Equal(t, s, PresentApproximateClock(tm, tolerance, LocaleEnUS))
+	}
+
+	assert.Equal(t, "halv fyra", PresentApproximateClock(clockAt(15, 27), 5*time.Minute, LocaleSvSE))
+}
+
+func TestPresentClockParses(t *testing.T) {
+	for _, locale := range []string{LocaleSvSE, LocaleEnUS} {
+		for minute := 0; minute < 60; minute++ {
+			in := clockAt(7, minute)
+			s := PresentClock(in, locale, ClockExact)
+			out, err := ParseTime(s)
+			assert.Equal(t, nil, err, s)
+			assert.Equal(t, in.Hour(), out.Hour(), s)
+			assert.Equal(t, in.Minute(), out.Minute(), s)
+
+			s = PresentApproximateClock(in, time.Minute, locale)
+			_, err = ParseTime(s)
+			assert.Equal(t, nil, err, s)
+		}
+
+		// the part of day gives the hour on a 12 hour clock
+		for hour := 0; hour < 24; hour++ {
+			for _, minute := range []int{0, 5, 15, 25, 30, 35, 45, 58} {
+				in := clockAt(hour, minute)
+				s := PresentClock(in, locale, Clock12h)
+				out, err := ParseTime(s)
+				assert.Equal(t, nil, err, s)
+				assert.Equal(t, in.Hour(), out.Hour(), s)
+				assert.Equal(t, in.Minute(), out.Minute(), s)
+			}
+		}
+	}
+}
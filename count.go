@@ -15,6 +15,10 @@ func PresentCountEnglish(n int) string {
 	if n > 20 && n < 100 {
 		tens := (n / 10) % 10
 		ones := n % 10
+		if ones == 0 {
+			// "thirty" => "thirtieth"
+			return strings.TrimSuffix(tensEnUS[tens], "y") + "ieth"
+		}
 		return tensEnUS[tens] + countNamesEnUS[ones]
 	}
 
@@ -53,6 +57,10 @@ func PresentCountSwedish(n int) string {
 	if n > 20 && n < 100 {
 		tens := (n / 10) % 10
 		ones := n % 10
+		if ones == 0 {
+			// "trettio" => "trettionde"
+			return tensSvSE[tens] + "nde"
+		}
 		return tensSvSE[tens] + countNamesSvSE[ones]
 	}
 
@@ -113,7 +121,7 @@ func parseCountSwedish(s string) (decimal.Decimal, error) {
 		if len(s) >= len(prefix) && s[0:len(prefix)] == prefix {
 			if tens, err := arrayIndex(prefix, tensSvSE); err == nil {
 				restStr := s[len(prefix):]
-				if restStr != "" {
+				if restStr != "" && restStr != "nde" {
 					res, err = parseCountSwedish(restStr)
 					if err != nil {
 						return res, err
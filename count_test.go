@@ -20,6 +20,7 @@ func TestParseCount(t *testing.T) {
 		// swe
 		"trettonde":             "13",
 		"tjugotredje":           "23",
+		"trettionde":            "30",
 		"hundranittonde":        "119",
 		"etthundranittiosjunde": "197",
 		"nittonhundranionde":    "1909",
@@ -40,6 +41,7 @@ func TestPresentCount(t *testing.T) {
 	expectedSV := map[int]string{
 		9:  "nionde",
 		13: "trettonde",
+		30: "trettionde",
 		91: "nittioförsta",
 	}
 	for n, expect := range expectedSV {
@@ -49,6 +51,7 @@ func TestPresentCount(t *testing.T) {
 	expectedEN := map[int]string{
 		9:  "ninth",
 		13: "thirteenth",
+		30: "thirtieth",
 		91: "ninetyfirst",
 	}
 	for n, expect := range expectedEN {
@@ -70,6 +73,7 @@ func TestPresentCountShort(t *testing.T) {
 		3:  "3:rd",
 		9:  "9:th",
 		13: "13:th",
+		30: "30:th",
 		91: "91:st",
 	}
 	for n, expect := range expectedEN {
@@ -0,0 +1,333 @@
+package natural
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	cronMonths = []string{"", "jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+	cronWeekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+// ParseCron parses a standard 5-field cron expression, "minute hour day month weekday",
+// such as "0 9 * * 1-5", into a Recurrence starting at the beginning of today.
+// Expressions restricting both day of month and weekday are not supported
+func ParseCron(s string) (Recurrence, error) {
+	r := Recurrence{Interval: 1, Start: beginningOfDay(time.Now())}
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) != 5 {
+		return r, fmt.Errorf("Cron expression needs 5 fields: %s", s)
+	}
+
+	minutes, minuteStep, err := parseCronField(fields[0], 0, 59, nil)
+	if err != nil {
+		return r, err
+	}
+	hours, hourStep, err := parseCronField(fields[1], 0, 23, nil)
+	if err != nil {
+		return r, err
+	}
+	days, dayStep, err := parseCronField(fields[2], 1, 31, nil)
+	if err != nil {
+		return r, err
+	}
+	months, monthStep, err := parseCronField(fields[3], 1, 12, cronMonths)
+	if err != nil {
+		return r, err
+	}
+	weekdays, weekdayStep, err := parseCronField(fields[4], 0, 7, cronWeekdays)
+	if err != nil {
+		return r, err
+	}
+	// cron restarts an uneven step every hour or day, "*/7" is 0,7,...,56
+	if 60%minuteStep != 0 {
+		minutes, minuteStep = cronSteps(59, minuteStep), 1
+	}
+	if 24%hourStep != 0 {
+		hours, hourStep = cronSteps(23, hourStep), 1
+	}
+	if dayStep > 1 || monthStep > 1 || weekdayStep > 1 {
+		return r, fmt.Errorf("Unsupported step in cron expression: %s", s)
+	}
+	if days != nil && weekdays != nil {
+		return r, fmt.Errorf("Cron expression with both day of month and weekday: %s", s)
+	}
+
+	switch {
+	case minutes == nil:
+		// "*/15 * * * *", "*/5 9-17 * * *"
+		r.Freq, r.Interval = FreqMinutely, minuteStep
+		r.ByHour = hours
+		if hourStep > 1 {
+			return r, fmt.Errorf("Unsupported step in cron expression: %s", s)
+		}
+	case hours == nil:
+		// "0 * * * *", "30 */2 * * *"
+		r.Freq, r.Interval = FreqHourly, hourStep
+		r.ByMinute = minutes
+	default:
+		r.Freq = FreqDaily
+		r.ByHour, r.ByMinute = hours, minutes
+	}
+
+	for _, m := range months {
+		r.ByMonth = append(r.ByMonth, time.Month(m))
+	}
+	for _, wd := range weekdays {
+		r.ByDay = append(r.ByDay, NthWeekday{Weekday: time.Weekday(wd % 7)})
+	}
+	r.ByMonthDay = days
+
+	if r.Freq == FreqDaily {
+		switch {
+		case days != nil && months != nil:
+			r.Freq = FreqYearly
+		case days != nil:
+			r.Freq = FreqMonthly
+		case weekdays != nil:
+			r.Freq = FreqWeekly
+		}
+	}
+	return r, nil
+}
+
+// parseCronField parses a cron field into its values, or nil for "*", and a step
+// as in "*/15". names are lowercase aliases for values, indexed by value
+func parseCronField(s string, min, max int, names []string) ([]int, int, error) {
+	if s == "*" {
+		return nil, 1, nil
+	}
+	if strings.HasPrefix(s, "*/") {
+		step, err := strconv.Atoi(s[2:])
+		if err != nil || step < 1 {
+			return nil, 0, fmt.Errorf("Invalid cron step: %s", s)
+		}
+		return nil, step, nil
+	}
+
+	value := func(v string) (int, error) {
+		if idx, err := arrayIndex(v, names); err == nil && v != "" {
+			return idx, nil
+		}
+		i, err := strconv.Atoi(v)
+		if err != nil || i < min || i > max {
+			return 0, fmt.Errorf("Invalid cron value: %s", v)
+		}
+		return i, nil
+	}
+
+	var res []int
+	seen := map[int]bool{}
+	for _, part := range strings.Split(s, ",") {
+		step := 1
+		if idx := strings.Index(part, "/"); idx != -1 {
+			var err error
+			if step, err = strconv.Atoi(part[idx+1:]); err != nil || step < 1 {
+				return nil, 0, fmt.Errorf("Invalid cron step: %s", part)
+			}
+			part = part[:idx]
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		from, err := value(bounds[0])
+		if err != nil {
+			return nil, 0, err
+		}
+		to := from
+		if len(bounds) == 2 {
+			if to, err = value(bounds[1]); err != nil {
+				return nil, 0, err
+			}
+			if to < from && names != nil && len(names) == 7 {
+				// "fri-mon" wraps around the week
+				to += 7
+			} else if to < from {
+				return nil, 0, fmt.Errorf("Invalid cron range: %s", part)
+			}
+		} else if step > 1 {
+			to = max
+		}
+		for i := from; i <= to; i += step {
+			// 7 is also sunday
+			v := i
+			if names != nil && len(names) == 7 {
+				v = i % 7
+			}
+			if !seen[v] {
+				seen[v] = true
+				res = append(res, v)
+			}
+		}
+	}
+	return res, 1, nil
+}
+
+// ToCron converts a repeating schedule such as "varje natt kl 03", "var femtonde
+// minut" or "every weekday at 9am" into a 5-field cron expression, see ParseRecurrence.
+// Schedules cron cannot express, such as "varannan vecka", return an error
+func ToCron(s string) (string, error) {
+	r, err := ParseRecurrence(s)
+	if err != nil {
+		return "", err
+	}
+	cron, err := r.Cron()
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", err, s)
+	}
+	return cron, nil
+}
+
+// Cron returns r as a 5-field cron expression, or an error if cron cannot express it
+func (r Recurrence) Cron() (string, error) {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	if r.Count > 0 || !r.Until.IsZero() {
+		return "", fmt.Errorf("Cron cannot limit the number of runs")
+	}
+	for _, d := range r.ByDay {
+		if d.N != 0 {
+			return "", fmt.Errorf("Cron cannot express the nth weekday of a month")
+		}
+	}
+	for _, d := range r.ByMonthDay {
+		if d < 1 {
+			return "", fmt.Errorf("Cron cannot count days from the end of the month")
+		}
+	}
+	if len(r.ByDay) > 0 && len(r.ByMonthDay) > 0 {
+		return "", fmt.Errorf("Cron cannot combine weekdays and days of month")
+	}
+
+	minute, hour, day, month, weekday := "0", "0", "*", "*", "*"
+	if len(r.ByMinute) > 0 {
+		minute = cronList(r.ByMinute)
+	}
+	if len(r.ByHour) > 0 {
+		hour = cronList(r.ByHour)
+	}
+	if len(r.ByMonthDay) > 0 {
+		day = cronList(r.ByMonthDay)
+	}
+	if len(r.ByMonth) > 0 {
+		months := []int{}
+		for _, m := range r.ByMonth {
+			months = append(months, int(m))
+		}
+		month = cronList(months)
+	}
+	if len(r.ByDay) > 0 {
+		weekday = cronList(weekdayInts(plainWeekdays(r.ByDay)))
+	}
+
+	switch r.Freq {
+	case FreqMinutely:
+		if 60%interval != 0 {
+			return "", fmt.Errorf("Cron cannot repeat every %d minutes", interval)
+		}
+		minute = cronStep(interval)
+		if len(r.ByHour) == 0 {
+			hour = "*"
+		}
+	case FreqHourly:
+		if 24%interval != 0 {
+			return "", fmt.Errorf("Cron cannot repeat every %d hours", interval)
+		}
+		switch {
+		case len(r.ByHour) > 0 && interval > 1:
+			return "", fmt.Errorf("Cron cannot repeat every %d hours within given hours", interval)
+		case len(r.ByHour) == 0:
+			hour = cronStep(interval)
+		}
+	case FreqDaily:
+		if interval > 1 {
+			return "", fmt.Errorf("Cron cannot repeat every %d days", interval)
+		}
+	case FreqWeekly:
+		if interval > 1 {
+			return "", fmt.Errorf("Cron cannot repeat every %d weeks", interval)
+		}
+		if len(r.ByDay) == 0 {
+			weekday = strconv.Itoa(int(r.Start.Weekday()))
+		}
+	case FreqMonthly:
+		if 12%interval != 0 {
+			return "", fmt.Errorf("Cron cannot repeat every %d months", interval)
+		}
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			day = strconv.Itoa(r.Start.Day())
+		}
+		if interval > 1 {
+			// counted from the start month, "*/2" would count from january
+			months := []int{}
+			for m := 0; m < 12; m += interval {
+				months = append(months, (int(r.Start.Month())-1+m)%12+1)
+			}
+			sort.Ints(months)
+			month = cronList(months)
+		}
+	case FreqYearly:
+		if interval > 1 {
+			return "", fmt.Errorf("Cron cannot repeat every %d years", interval)
+		}
+		if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+			day = strconv.Itoa(r.Start.Day())
+		}
+		if len(r.ByMonth) == 0 {
+			month = strconv.Itoa(int(r.Start.Month()))
+		}
+	}
+	return strings.Join([]string{minute, hour, day, month, weekday}, " "), nil
+}
+
+// cronSteps returns 0, step, 2*step and so on up to max
+func cronSteps(max, step int) []int {
+	res := []int{}
+	for i := 0; i <= max; i += step {
+		res = append(res, i)
+	}
+	return res
+}
+
+func cronStep(n int) string {
+	if n <= 1 {
+		return "*"
+	}
+	return fmt.Sprintf("*/%d", n)
+}
+
+// cronList returns values as "1-5" or "0,6", with runs of three or more as ranges
+func cronList(values []int) string {
+	sorted := append([]int{}, values...)
+	sort.Ints(sorted)
+	parts := []string{}
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] == sorted[j]+1 {
+			j++
+		}
+		switch {
+		case j-i >= 2:
+			parts = append(parts, fmt.Sprintf("%d-%d", sorted[i], sorted[j]))
+		case j > i:
+			parts = append(parts, strconv.Itoa(sorted[i]), strconv.Itoa(sorted[j]))
+		default:
+			parts = append(parts, strconv.Itoa(sorted[i]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
+
+func weekdayInts(days []time.Weekday) []int {
+	res := []int{}
+	for _, wd := range days {
+		res = append(res, int(wd))
+	}
+	return res
+}
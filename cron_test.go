@@ -0,0 +1,142 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCron(t *testing.T) {
+	expected := map[string]string{
+		"0 9 * * 1-5":      "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0",
+		"*/15 * * * *":     "FREQ=MINUTELY;INTERVAL=15",
+		"*/5 9-11 * * *":   "FREQ=MINUTELY;INTERVAL=5;BYHOUR=9,10,11",
+		"0 * * * *":        "FREQ=HOURLY;BYMINUTE=0",
+		"30 */2 * * *":     "FREQ=HOURLY;INTERVAL=2;BYMINUTE=30",
+		"0 3 * * *":        "FREQ=DAILY;BYHOUR=3;BYMINUTE=0",
+		"0 6 1 * *":        "FREQ=MONTHLY;BYMONTHDAY=1;BYHOUR=6;BYMINUTE=0",
+		"0 0 24 12 *":      "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24;BYHOUR=0;BYMINUTE=0",
+		"0,30 8 * * sun":   "FREQ=WEEKLY;BYDAY=SU;BYHOUR=8;BYMINUTE=0,30",
+		"0 12 * jun-aug 7": "FREQ=WEEKLY;BYMONTH=6,7,8;BYDAY=SU;BYHOUR=12;BYMINUTE=0",
+		"0 9 * * 5-1":      "FREQ=WEEKLY;BYDAY=FR,SA,SU,MO;BYHOUR=9;BYMINUTE=0",
+		"0 9 * * fri-mon":  "FREQ=WEEKLY;BYDAY=FR,SA,SU,MO;BYHOUR=9;BYMINUTE=0",
+	}
+	for in, expect := range expected {
+		r, err := ParseCron(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, r.String(), in)
+	}
+
+	for _, in := range []string{"", "* * * *", "60 * * * *", "0 9 1 * 1", "0 0 */2 * *", "0 0 * * funday", "0 17-9 * * *", "0 0 * dec-feb *"} {
+		_, err := ParseCron(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
+
+func TestParseCronUnevenStep(t *testing.T) {
+	expected := map[string]string{
+		"*/7 * * * *":    "0,7,14,21,28,35,42,49,56 * * * *",
+		"0 */5 * * *":    "0 0,5,10,15,20 * * *",
+		"*/25 */7 * * *": "0,25,50 0,7,14,21 * * *",
+	}
+	for in, expect := range expected {
+		r, err := ParseCron(in)
+		assert.Equal(t, nil, err, in)
+		res, err := r.Cron()
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, res, in)
+	}
+
+	// the count restarts at midnight and at every hour
+	start := time.Date(2024, 4, 17, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 4, 17, 23, 50, 0, 0, time.UTC)
+	occurrences := map[string][]string{
+		"*/7 * * * *": {"2024-04-17 23:56", "2024-04-18 00:00", "2024-04-18 00:07"},
+		"0 */5 * * *": {"2024-04-18 00:00", "2024-04-18 05:00", "2024-04-18 10:00"},
+	}
+	for in, expect := range occurrences {
+		r, err := ParseCron(in)
+		assert.Equal(t, nil, err, in)
+		r.Start = start
+		res := []string{}
+		for _, occ := range r.Occurrences(now, 3) {
+			res = append(res, occ.Format("2006-01-02 15:04"))
+		}
+		assert.Equal(t, expect, res, in)
+	}
+}
+
+func TestToCron(t *testing.T) {
+	expected := map[string]string{
+		"varje natt kl 03":                    "0 3 * * *",
+		"var femtonde minut":                  "*/15 * * * *",
+		"första dagen i varje månad kl 06:00": "0 6 1 * *",
+		"varje vardag kl 08:30":               "30 8 * * 1-5",
+		"varje lördag och söndag kl 10":       "0 10 * * 0,6",
+		"varje mån, ons och fre kl 7":         "0 7 * * 1,3,5",
+		"varannan timme":                      "0 */2 * * *",
+		"varje timme":                         "0 * * * *",
+		"den 15:e varje månad kl 12":          "0 12 15 * *",
+		"every weekday at 9am":                "0 9 * * 1-5",
+		"every 5 minutes":                     "*/5 * * * *",
+		"every day at 23:45":                  "45 23 * * *",
+		"varje dag kl 9 och 17":               "0 9,17 * * *",
+		"varje timme kl 9-17":                 "0 9-17 * * *",
+	}
+	for in, expect := range expected {
+		res, err := ToCron(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, res, in)
+	}
+
+	for _, in := range []string{
+		"varannan vecka",
+		"var tredje dag",
+		"var sjunde minut",
+		"den första tisdagen varje månad",
+		"sista dagen i varje månad",
+		"varje måndag, 10 gånger",
+		"every other friday",
+		"ibland",
+	} {
+		_, err := ToCron(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
+
+func TestRecurrenceCron(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	expected := map[string]string{
+		"varje vecka":    "0 0 * * 3",
+		"varje månad":    "0 0 17 * *",
+		"varannan månad": "0 0 17 2,4,6,8,10,12 *",
+		"varje år":       "0 0 17 4 *",
+	}
+	for in, expect := range expected {
+		r, err := p.ParseRecurrence(in)
+		assert.Equal(t, nil, err, in)
+		res, err := r.Cron()
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, res, in)
+	}
+
+	rules := map[string]string{
+		"FREQ=HOURLY;BYHOUR=9,10,11":           "0 9-11 * * *",
+		"FREQ=HOURLY;BYMINUTE=15;BYHOUR=8,12":  "15 8,12 * * *",
+		"FREQ=HOURLY;INTERVAL=2;BYMINUTE=30":   "30 */2 * * *",
+		"FREQ=MINUTELY;INTERVAL=5;BYHOUR=9,10": "*/5 9,10 * * *",
+	}
+	for in, expect := range rules {
+		r, err := ParseRRule(in)
+		assert.Equal(t, nil, err, in)
+		res, err := r.Cron()
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, res, in)
+	}
+
+	r, err := ParseRRule("FREQ=HOURLY;INTERVAL=2;BYHOUR=9,10,11")
+	assert.Equal(t, nil, err)
+	_, err = r.Cron()
+	assert.NotEqual(t, nil, err)
+}
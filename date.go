@@ -10,6 +10,42 @@ import (
 	"time"
 )
 
+var (
+	// days relative to today
+	relativeDays = map[string]int{
+		// swe
+		"i förrgår": -2, "iförrgår": -2,
+		"igår": -1, "i går": -1,
+		"idag": 0, "i dag": 0,
+		"imorgon": 1, "i morgon": 1, "imorrn": 1, "imorron": 1, "i morron": 1,
+		"i övermorgon": 2, "iövermorgon": 2,
+		// eng
+		"the day before yesterday": -2,
+		"yesterday":                -1,
+		"today":                    0,
+		"tomorrow":                 1,
+		"the day after tomorrow":   2,
+	}
+
+	// aWhile is "om en stund", "in a while"
+	aWhile = 30 * time.Minute
+
+	// "9.15", "22-02", an hour and minutes rather than a day and month
+	clockLikeRegex = regexp.MustCompile(`^(?:[01]?\d|2[0-3])[-.][0-5]\d$`)
+
+	// "9.15", "kl 18.30"
+	dottedClockRegex = regexp.MustCompile(`^((?:kl |klockan )?(?:[01]?\d|2[0-3]))\.([0-5]\d)$`)
+
+	// "fredag", "på fredag", "on friday"
+	weekdayRegex = regexp.MustCompile(`^(?:på |on )?(\pL+)$`)
+
+	// "may 3", "May 3rd, 2024 at 9:30"
+	monthDayRegex = regexp.MustCompile(`^(?:on )?(\pL+)\.? (\d{1,2}(?::?(?:st|nd|rd|th))?)(?:,? ?(\d{4}))?(?:,? (?:at )?(\d{1,2}(?:[:.]\d{2}){0,2}(?: ?(?:am|pm))?))?$`)
+
+	// "den 28:e mars", "3 maj 2024 kl 9", "femte maj 19:31:10, 2015"
+	dayMonthRegex = regexp.MustCompile(`^(?:den |the )?([\pL\d:]+) (?:of )?(\pL+)\.?(?:,? ?(\d{4}))?(?: (?:kl\.? |klockan |at )?(\d{1,2}(?:[:.]\d{2}){0,2}))?(?:,? ?(\d{4}))?$`)
+)
+
 // ParseWeekday parses a weekday name into a time.Weekday
 func ParseWeekday(s string) (time.Weekday, error) {
 	s = ucFirst(s)
@@ -23,55 +59,157 @@ func ParseWeekday(s string) (time.Weekday, error) {
 	return 0, fmt.Errorf("Cannot parse weekday: %s", s)
 }
 
-// ParseTime parses a string like HH:MM, HH:MM:SS, "klockan sex på kvällen" etc into a time.Time
+// ParseTime parses a string like HH:MM, HH:MM:SS, "klockan sex på kvällen" etc into a time.Time,
+// see Parser.ParseTime
 func ParseTime(s string) (time.Time, error) {
-	t := time.Now()
+	return NewParser(LocaleSvSE).ParseTime(s)
+}
+
+// ParseTime parses a string like HH:MM, HH:MM:SS, "klockan sex på kvällen" etc into a time.Time
+// relative to the reference time. A trailing time zone, as in "kl 15 svensk tid", "3pm EST"
+// or "9:30 UTC+2", is applied to the result, otherwise the parser Location is used.
+// Times can be given relative to another, as in "en dag före julafton" or "the monday after easter"
+func (p *Parser) ParseTime(s string) (time.Time, error) {
+	t := p.now()
 	if s == "" {
 		return t, fmt.Errorf("empty")
 	}
 
+	// "kl 15 svensk tid", "3pm EST"
+	if rest, loc, ok := splitZone(s); ok {
+		q := *p
+		q.Location = loc
+		return q.ParseTime(rest)
+	}
+
+	// "3pm" => "3 pm"
+	s = clockSuffixRegex.ReplaceAllString(s, "$1 $2")
+
+	// "strax efter tre", "nästan halv fyra", "just after three"
+	if match := approximateClockRegex.FindStringSubmatch(s); match != nil {
+		return p.ParseTime(match[1])
+	}
+
+	if s == "nu" || s == "just nu" || s == "now" || s == "right now" {
+		return t, nil
+	}
+
+	// "om en stund", "in a while"
+	if s == "om en stund" || s == "in a while" || s == "in a bit" {
+		return t.Add(aWhile), nil
+	}
+
+	// "två timmar efter lunch", "the monday after easter"
+	if res, ok, err := p.parseAnchored(s); ok {
+		return res, err
+	}
+
+	now := t
+	// clock times are moved a day at a time, by the parser preference
+	clock := func(t time.Time) (time.Time, error) {
+		return p.prefer(Interval{Start: t, End: t}, now, 0, 1).Start, nil
+	}
+
 	t = setMinute(t, 0)
 	t = setSecond(t, 0)
 
 	if num, err := strconv.ParseInt(s, 10, 64); err == nil {
-		return setHour(t, num), nil
+		return clock(setHour(t, num))
+	}
+
+	if s == "middag" || s == "lunch" || s == "noon" {
+		return clock(setHour(t, 12))
 	}
 
-	if s == "middag" || s == "lunch" {
-		return setHour(t, 12), nil
+	if s == "midnatt" || s == "midnight" {
+		return clock(setHour(t, 0))
 	}
 
-	if s == "midnatt" || s == "natt" {
-		return setHour(t, 0), nil
+	// "på morgonen", "imorgon kväll", "fredag eftermiddag"
+	if i, err := p.ParseDayPart(s); err == nil {
+		return i.Start, nil
 	}
 
 	timeBase := int64(0)
+	night := false
 
 	// https://sv.wikipedia.org/wiki/F%C3%B6rmiddag
-	re := regexp.MustCompile(`^(?P<time>[\w\d\s]+)+ (?:på morgonen|på förmiddagen|förmiddag|fm)+$`)
+	re := regexp.MustCompile(`^(?P<time>[\pL\d\s]+)+ (?:på morgonen|på förmiddagen|förmiddag|fm)+$`)
 	match := re.FindAllStringSubmatch(s, -1)
 	if len(match) != 0 {
 		s = match[0][1]
 	}
 
+	// "quarter past seven in the morning"
+	re = regexp.MustCompile(`^(?P<time>.+) (?:in the morning|am)$`)
+	match = re.FindAllStringSubmatch(s, -1)
+	if len(match) != 0 {
+		s = match[0][1]
+	}
+
+	// "kvart över tio på natten", "eleven at night"
+	re = regexp.MustCompile(`^(?P<time>.+) (?:på natten|at night)$`)
+	match = re.FindAllStringSubmatch(s, -1)
+	if len(match) != 0 {
+		night = true
+		s = match[0][1]
+	}
+
 	// https://sv.wikipedia.org/wiki/Eftermiddag
-	re = regexp.MustCompile(`^(?P<time>[\w\d\s]+)+ (på kvällen|i kväll|på eftermiddagen|eftermiddag|em)+$`)
+	re = regexp.MustCompile(`^(?P<time>[\pL\d\s]+)+ (på kvällen|i kväll|på eftermiddagen|eftermiddag|em)+$`)
 	match = re.FindAllStringSubmatch(s, -1)
 	if len(match) != 0 {
 		timeBase = 12
 		s = match[0][1]
 	}
 
-	if s == "idag" || s == "i dag" {
-		return beginningOfDay(t), nil
+	// "twenty to eleven in the evening"
+	re = regexp.MustCompile(`^(?P<time>.+) (?:in the afternoon|in the evening|pm)$`)
+	match = re.FindAllStringSubmatch(s, -1)
+	if len(match) != 0 {
+		timeBase = 12
+		s = match[0][1]
 	}
 
-	if s == "igår" || s == "i går" {
-		return subDay(t, 1), nil
+	// hour returns the hour of day of hr on a 12 hour clock, "tolv på eftermiddagen"
+	// is noon, and at night the hours from six are in the evening
+	hour := func(hr int64) int64 {
+		switch {
+		case night && hr == 12:
+			return 0
+		case night && hr >= 6 && hr < 12:
+			return hr + 12
+		case timeBase == 12 && hr == 12:
+			return hr
+		}
+		return timeBase + hr
 	}
 
-	if s == "imorgon" || s == "i morgon" || s == "imorrn" || s == "imorron" || s == "i morron" {
-		return addDay(t, 1), nil
+	// "idag", "i förrgår", "the day after tomorrow"
+	if diff, ok := relativeDays[s]; ok {
+		return addDay(t, diff), nil
+	}
+
+	// "28/3", "2024-03-28", "28/3 -24" in the orders of the parser locale, but
+	// not "9.15" or "22-02" which may be clock times
+	if numericDateRegex.MatchString(s) && !clockLikeRegex.MatchString(s) {
+		res, err := parseNumericDate(s, p.Locale, false)
+		if _, ok := err.(*AmbiguousDateError); ok {
+			err = nil
+		}
+		if err == nil {
+			res = time.Date(res.Year(), res.Month(), res.Day(), 0, 0, 0, 0, t.Location())
+			if match := numericDateRegex.FindStringSubmatch(s); len(match[1]) < 4 && match[5] == "" && match[6] == "" {
+				// "28/3"
+				res = p.preferYear(res.AddDate(now.Year()-res.Year(), 0, 0), now, false)
+			}
+			return res, nil
+		}
+	}
+
+	// "9.15", "kl 18.30"
+	if match := dottedClockRegex.FindStringSubmatch(s); match != nil {
+		s = match[1] + ":" + match[2]
 	}
 
 	// "18:23:59", "18:23", "18", "kl 18:30"
@@ -83,7 +221,7 @@ func ParseTime(s string) (time.Time, error) {
 			return t, err
 		}
 		hr := _hr.IntPart()
-		t = setHour(t, timeBase+hr)
+		t = setHour(t, hour(hr))
 		if match[0][2] != "" {
 			_mn, err := ParseNumber(match[0][2])
 			mn := _mn.IntPart()
@@ -100,48 +238,69 @@ func ParseTime(s string) (time.Time, error) {
 			}
 			t = setSecond(t, sc)
 		}
-		return t, nil
+		return clock(t)
 	}
 
-	re = regexp.MustCompile(`^kvart i (?P<time>[\w\d]+)+$`)
+	re = regexp.MustCompile(`^kvart i (?P<time>[\pL\d]+)+$`)
 	match = re.FindAllStringSubmatch(s, -1)
 	if len(match) != 0 {
 		_hr, err := ParseNumber(match[0][1])
 		hr := _hr.IntPart()
 		if err == nil {
-			t = setHour(t, timeBase+hr-1)
+			t = setHour(t, hour(hr-1))
 			t = setMinute(t, 45)
-			return t, nil
+			return clock(t)
 		}
 	}
 
-	re = regexp.MustCompile(`^kvart över (?P<time>[\w\d]+)+$`)
+	re = regexp.MustCompile(`^kvart över (?P<time>[\pL\d]+)+$`)
 	match = re.FindAllStringSubmatch(s, -1)
 	if len(match) != 0 {
 		_hr, err := ParseNumber(match[0][1])
 		hr := _hr.IntPart()
 		if err == nil {
-			t = setHour(t, timeBase+hr)
+			t = setHour(t, hour(hr))
 			t = setMinute(t, 15)
-			return t, nil
+			return clock(t)
 		}
 	}
 
 	// "halv elva", "halv elva på morgonen"
-	re = regexp.MustCompile(`^halv (?P<time>[\w\d\s]+)+$`)
+	re = regexp.MustCompile(`^halv (?P<time>[\pL\d\s]+)+$`)
 	match = re.FindAllStringSubmatch(s, -1)
 	if len(match) != 0 {
 		_hr, err := ParseNumber(match[0][1])
 		hr := _hr.IntPart()
 		if err == nil {
-			t = setHour(t, timeBase+hr-1)
+			t = setHour(t, hour(hr-1))
 			t = setMinute(t, 30)
-			return t, nil
+			return clock(t)
+		}
+	}
+
+	// "fem i halv tre", "tre minuter över halv tre"
+	re = regexp.MustCompile(`^(?P<min>[\pL\d]+)(?: minuter| minut| min)? (?P<dir>i|över) halv (?P<time>[\pL\d]+)$`)
+	match = re.FindAllStringSubmatch(s, -1)
+	if len(match) != 0 {
+		_mn, err := ParseNumber(match[0][1])
+		mn := _mn.IntPart()
+		if err == nil {
+			if match[0][2] == "i" {
+				t = setMinute(t, 30-mn)
+			} else {
+				t = setMinute(t, 30+mn)
+			}
+			_hr, err := ParseNumber(match[0][3])
+			hr := _hr.IntPart()
+			if err == nil {
+				t = setHour(t, hour(hr-1))
+				return clock(t)
+			}
 		}
 	}
 
 	// "tjugo över elva", "tjugo minuter över elva"
-	re = regexp.MustCompile(`^(?P<min>[\w\d]+)+(?: minuter| min)? över (?P<time>[\w\d]+)+$`)
+	re = regexp.MustCompile(`^(?P<min>[\pL\d]+)+(?: minuter| minut| min)? över (?P<time>[\pL\d]+)+$`)
 	match = re.FindAllStringSubmatch(s, -1)
 	if len(match) != 0 {
 		_mn, err := ParseNumber(match[0][1])
@@ -151,14 +310,14 @@ func ParseTime(s string) (time.Time, error) {
 			_hr, err := ParseNumber(match[0][2])
 			hr := _hr.IntPart()
 			if err == nil {
-				t = setHour(t, timeBase+hr)
-				return t, nil
+				t = setHour(t, hour(hr))
+				return clock(t)
 			}
 		}
 	}
 
 	// "tjugo i elva", "tjugo minuter i elva"
-	re = regexp.MustCompile(`^(?P<min>[\w\d]+)+ (?:minuter |min )?i (?P<time>[\w\d]+)+$`)
+	re = regexp.MustCompile(`^(?P<min>[\pL\d]+)+ (?:minuter |minut |min )?i (?P<time>[\pL\d]+)+$`)
 	match = re.FindAllStringSubmatch(s, -1)
 	if len(match) != 0 {
 		_mm, err := ParseNumber(match[0][1])
@@ -168,8 +327,8 @@ func ParseTime(s string) (time.Time, error) {
 			_hr, err := ParseNumber(match[0][2])
 			hr := _hr.IntPart()
 			if err == nil {
-				t = setHour(t, timeBase+hr-1)
-				return t, nil
+				t = setHour(t, hour(hr-1))
+				return clock(t)
 			}
 		}
 	}
@@ -188,37 +347,123 @@ func ParseTime(s string) (time.Time, error) {
 				if hr > 12 {
 					timeBase = 0
 				}
-				t = setHour(t, timeBase+hr)
-				return t, nil
+				t = setHour(t, hour(hr))
+				return clock(t)
 			}
 		}
 	}
 
-	// "den 28:e mars", "the 28:th of may"
-	re = regexp.MustCompile(`^(?:den |the )?(?P<day>[\w:]+)? (?:of )?(?P<month>[\w]+)\s?(kl |klockan |at )?(?P<time>[:\d]+)?(?:,?)\s?(?P<year>[0-9]+)?$`)
+	// "quarter past seven", "half past two", "twenty-five to three"
+	re = regexp.MustCompile(`^(?P<min>[\pL\d-]+)(?: minutes| minute)? (?P<dir>past|to) (?P<time>[\pL\d]+)$`)
 	match = re.FindAllStringSubmatch(s, -1)
 	if len(match) != 0 {
-		dd, err := ParseCount(match[0][1])
+		mn := int64(0)
+		switch match[0][1] {
+		case "quarter":
+			mn = 15
+		case "half":
+			mn = 30
+		default:
+			_mn, err := ParseNumber(strings.Replace(match[0][1], "-", "", -1))
+			if err != nil {
+				return t, err
+			}
+			mn = _mn.IntPart()
+		}
+		_hr, err := ParseNumber(match[0][3])
 		if err != nil {
 			return t, err
 		}
-		t = setDay(t, dd.IntPart())
-		month, err := ParseMonth(match[0][2])
+		hr := _hr.IntPart()
+		if match[0][2] == "to" {
+			t = setHour(t, hour(hr-1))
+			t = setMinute(t, 60-mn)
+		} else {
+			t = setHour(t, hour(hr))
+			t = setMinute(t, mn)
+		}
+		return clock(t)
+	}
+
+	// "seven o'clock"
+	re = regexp.MustCompile(`^(?P<time>[\pL\d]+) o'clock$`)
+	match = re.FindAllStringSubmatch(s, -1)
+	if len(match) != 0 {
+		_hr, err := ParseNumber(match[0][1])
 		if err != nil {
 			return t, err
 		}
-		t = setMonth(t, month)
+		t = setHour(t, hour(_hr.IntPart()))
+		return clock(t)
+	}
 
-		// XXX TODO parse time of day
-		return t, nil
+	// "vecka 12", "tisdag v. 12 2024"
+	if weekRegex.MatchString(s) {
+		return p.ParseWeek(s)
+	}
+
+	// "om tre arbetsdagar", "nästa vardag", "within 5 business days"
+	if res, err := p.ParseBusinessDays(s); err == nil {
+		return res, nil
+	}
+
+	// "julafton", "på midsommarafton", "on thanksgiving"
+	if h, err := p.ParseHoliday(s); err == nil {
+		return h.Date, nil
+	}
+
+	// "på Karins namnsdag"
+	if res, err := p.ParseNameDay(s); err == nil {
+		return res, nil
+	}
+
+	// "fredag", "på fredag", "on friday"
+	if match := weekdayRegex.FindStringSubmatch(s); match != nil {
+		if wd, err := ParseWeekday(match[1]); err == nil {
+			start := StartOfWeek(now, p.Locale)
+			day := addDay(start, (int(wd)-int(start.Weekday())+7)%7)
+			return p.prefer(dayInterval(day, 1), now, 0, 7).Start, nil
+		}
+	}
+
+	// "i helgen", "nästa vecka", "häromdagen"
+	if i, err := p.ParsePeriod(s); err == nil {
+		return i.Start, nil
+	}
+
+	// "may 3", "May 3rd, 2024 at 9:30"
+	if match := monthDayRegex.FindStringSubmatch(s); match != nil {
+		if month, err := ParseMonth(match[1]); err == nil {
+			res, err := dateWithClock(t, match[3], month, match[2], match[4])
+			if err != nil || match[3] != "" {
+				return res, err
+			}
+			return p.preferYear(res, now, match[4] != ""), nil
+		}
+	}
+
+	// "den 28:e mars", "the 28:th of may", "3 maj 2024 kl 9", "femte maj 19:31:10, 2015"
+	if match := dayMonthRegex.FindStringSubmatch(s); match != nil {
+		month, err := ParseMonth(match[2])
+		if err != nil {
+			return t, err
+		}
+		year := match[3]
+		if year == "" {
+			year = match[5]
+		}
+		res, err := dateWithClock(t, year, month, match[1], match[4])
+		if err != nil || year != "" {
+			return res, err
+		}
+		return p.preferYear(res, now, match[4] != ""), nil
 	}
 
 	// ex "sex"
 	_hr, err := ParseNumber(s)
 	if err == nil {
 		hr := _hr.IntPart()
-		t = setHour(t, timeBase+hr)
-		return t, nil
+		return clock(setHour(t, hour(hr)))
 	}
 
 	return t, fmt.Errorf("failed to parse: %s", s)
@@ -417,8 +662,16 @@ func ParseMonth(s string) (time.Month, error) {
 	return 0, fmt.Errorf("Cannot parse month: %s", s)
 }
 
-// ParseYear parses a 2 or 4 digit year string into a int
+// ParseYear parses a 2 or 4 digit year string into a int. Years with an era,
+// "200 f.Kr.", "44 BC" or "AD 800", are counted astronomically, so 1 BC is year 0
 func ParseYear(s string) (int, error) {
+	if rest, bc, ok := splitEra(strings.ToLower(strings.TrimSpace(s))); ok {
+		year, err := strconv.Atoi(rest)
+		if err != nil || year < 1 {
+			return 0, fmt.Errorf("Cannot parse year: %s", s)
+		}
+		return eraYear(year, bc), nil
+	}
 
 	year, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
@@ -443,6 +696,43 @@ func ParseYear(s string) (int, error) {
 	return int(year), nil
 }
 
+// preferYear moves t, a date given without a year, by whole years according to
+// the parser preference. Dates with a clock time are compared to now by the minute
+func (p *Parser) preferYear(t, now time.Time, clock bool) time.Time {
+	i := dayInterval(t, 1)
+	if clock {
+		i = Interval{Start: t, End: t}
+	}
+	return p.prefer(i, now, 1, 0).Start
+}
+
+// dateWithClock sets the date in t to day month year, and the time of day to clock
+// or midnight. An empty year keeps the year of t
+func dateWithClock(t time.Time, year string, month time.Month, day string, clock string) (time.Time, error) {
+	dd, err := parseOrdinal(day)
+	if err != nil {
+		return t, err
+	}
+	y := t.Year()
+	if year != "" {
+		if y, err = ParseYear(year); err != nil {
+			return t, err
+		}
+	}
+	res := time.Date(y, month, dd, 0, 0, 0, 0, t.Location())
+	if res.Day() != dd {
+		return t, fmt.Errorf("Invalid date: %d %s", dd, month)
+	}
+	if clock == "" {
+		return res, nil
+	}
+	c, err := ParseTime(clockSuffixRegex.ReplaceAllString(clock, "$1 $2"))
+	if err != nil {
+		return t, err
+	}
+	return setHour(time.Date(y, month, dd, 0, c.Minute(), c.Second(), 0, t.Location()), int64(c.Hour())), nil
+}
+
 func beginningOfDay(t time.Time) time.Time {
 	year, month, day := t.Date()
 	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
@@ -453,11 +743,6 @@ func addDay(t time.Time, diff int) time.Time {
 	return time.Date(year, month, day+diff, 0, 0, 0, 0, t.Location())
 }
 
-func subDay(t time.Time, diff int) time.Time {
-	year, month, day := t.Date()
-	return time.Date(year, month, day+diff, 0, 0, 0, 0, t.Location())
-}
-
 func setMonth(t time.Time, month time.Month) time.Time {
 	year, _, day := t.Date()
 	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
@@ -468,9 +753,18 @@ func setDay(t time.Time, day int64) time.Time {
 	return time.Date(year, month, int(day), t.Hour(), t.Minute(), t.Second(), 0, t.Location())
 }
 
+// setHour sets the hour of t. Times in a daylight saving gap are moved forward by
+// the length of the gap, 02:30 on a spring forward day in Sweden is 03:30
 func setHour(t time.Time, hour int64) time.Time {
 	year, month, day := t.Date()
-	return time.Date(year, month, day, int(hour), t.Minute(), t.Second(), 0, t.Location())
+	res := time.Date(year, month, day, int(hour), t.Minute(), t.Second(), 0, t.Location())
+	if res.Hour() == int(hour)%24 && res.Minute() == t.Minute() {
+		return res
+	}
+	// read the wall clock with the offset from before the gap
+	_, offset := time.Date(year, month, day-1, 12, 0, 0, 0, t.Location()).Zone()
+	utc := time.Date(year, month, day, int(hour), t.Minute(), t.Second(), 0, time.UTC)
+	return utc.Add(-time.Duration(offset) * time.Second).In(t.Location())
 }
 
 func setMinute(t time.Time, min int64) time.Time {
@@ -117,31 +117,72 @@ func TestRelative(t *testing.T) {
 	assert.Equal(t, nil, err)
 	assert.Equal(t, "the 28:th of March", readableDateNoYear(t1, "en_US"))
 
+	dates := map[string]string{
+		"2 maj, 2015":              "2015-05-02 00:00",
+		"3 maj,2015":               "2015-05-03 00:00",
+		"5:e maj 2015 kl 9":        "2015-05-05 09:00",
+		"femte maj 19:31:10, 2015": "2015-05-05 19:31",
+		"may 9, 2015":              "2015-05-09 00:00",
+		"May 3rd, 2024 at 9:30":    "2024-05-03 09:30",
+		"the 3rd of may 2024":      "2024-05-03 00:00",
+	}
+	for in, expect := range dates {
+		res, err := ParseTime(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, res.Format("2006-01-02 15:04"), in)
+	}
+
+	_, err = ParseTime("30 februari 2024")
+	assert.NotEqual(t, nil, err)
+
 	expected := map[string]string{
 		// swe
-		"6":                       "06:00",
-		"sex":                     "06:00",
-		"middag":                  "12:00",
-		"midnatt":                 "00:00",
-		"sex på morgonen":         "06:00",
-		"sex på kvällen":          "18:00",
-		"kvart i sju":             "06:45",
-		"kvart över sju":          "07:15",
-		"halv elva":               "10:30",
-		"halv elva på kvällen":    "22:30",
-		"tjugo över elva":         "11:20",
-		"tjugo minuter över elva": "11:20",
-		"tjugo i elva":            "10:40",
-		"tjugo minuter i elva":    "10:40",
-		"arton och trettio":       "18:30",
-		"kl 18":                   "18:00",
-		"klockan 18:30":           "18:30",
-		"18":                      "18:00",
-		"18:33":                   "18:33",
-		"18:33:59":                "18:33",
-		"idag":                    "00:00",
-		"igår":                    "INNAN 00:00", // XXX igår
-		"imorgon":                 "INNAN 00:00", // XXX i morgon
+		"6":                                "06:00",
+		"sex":                              "06:00",
+		"middag":                           "12:00",
+		"midnatt":                          "00:00",
+		"sex på morgonen":                  "06:00",
+		"sex på kvällen":                   "18:00",
+		"kvart i sju":                      "06:45",
+		"kvart över sju":                   "07:15",
+		"halv elva":                        "10:30",
+		"halv elva på kvällen":             "22:30",
+		"tjugo över elva":                  "11:20",
+		"tjugo minuter över elva":          "11:20",
+		"tjugo i elva":                     "10:40",
+		"tjugo minuter i elva":             "10:40",
+		"arton och trettio":                "18:30",
+		"kl 18":                            "18:00",
+		"klockan 18:30":                    "18:30",
+		"18":                               "18:00",
+		"18:33":                            "18:33",
+		"18:33:59":                         "18:33",
+		"fem i halv tre":                   "02:25",
+		"fem över halv åtta":               "07:35",
+		"kvart i åtta":                     "07:45",
+		"en minut över sju":                "07:01",
+		"strax efter tre":                  "03:00",
+		"nästan halv fyra":                 "03:30",
+		"lite efter kvart i sju":           "06:45",
+		"kvart över tio på natten":         "22:15",
+		"kvart över tolv på eftermiddagen": "12:15",
+		"tjugo i elva på kvällen":          "22:40",
+		"idag":                             "00:00",
+		"igår":                             "EFTER 00:00",
+		"imorgon":                          "INNAN 00:00",
+		// eng
+		"noon":                                 "12:00",
+		"seven o'clock":                        "07:00",
+		"quarter past seven":                   "07:15",
+		"half past two":                        "02:30",
+		"twenty-five past two":                 "02:25",
+		"quarter to seven":                     "06:45",
+		"twenty to eleven in the evening":      "22:40",
+		"three minutes past seven":             "07:03",
+		"just after three":                     "03:00",
+		"almost quarter to seven":              "06:45",
+		"quarter past twelve in the afternoon": "12:15",
+		"eleven at night":                      "23:00",
 	}
 
 	for s, i := range expected {
@@ -328,3 +369,51 @@ func dateLessThan(t1 time.Time, t2 time.Time) bool {
 	d2 := time.Date(t2.Year(), t2.Month(), t2.Day(), 0, 0, 0, 0, t2.Location())
 	return d1.Unix() < d2.Unix()
 }
+
+func TestParseTimeRelativeDays(t *testing.T) {
+	// the test parser is at wednesday 2024-04-17 15:30
+	expected := map[string]string{
+		// swe
+		"i förrgår":          "2024-04-15 00:00",
+		"igår":               "2024-04-16 00:00",
+		"idag":               "2024-04-17 00:00",
+		"imorgon":            "2024-04-18 00:00",
+		"i övermorgon":       "2024-04-19 00:00",
+		"i eftermiddag":      "2024-04-17 12:00",
+		"i kväll":            "2024-04-17 18:00",
+		"i natt":             "2024-04-17 22:00",
+		"i helgen":           "2024-04-20 00:00",
+		"häromdagen":         "2024-04-10 00:00",
+		"nu":                 "2024-04-17 15:30",
+		"om en stund":        "2024-04-17 16:00",
+		"i övermorgon kväll": "2024-04-19 18:00",
+		// eng
+		"the day before yesterday": "2024-04-15 00:00",
+		"yesterday":                "2024-04-16 00:00",
+		"today":                    "2024-04-17 00:00",
+		"tomorrow":                 "2024-04-18 00:00",
+		"the day after tomorrow":   "2024-04-19 00:00",
+		"right now":                "2024-04-17 15:30",
+		"in a while":               "2024-04-17 16:00",
+		"tomorrow evening":         "2024-04-18 18:00",
+		"last night":               "2024-04-16 22:00",
+	}
+	p := testParser(LocaleSvSE)
+	for in, exp := range expected {
+		res, err := p.ParseTime(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, res.Format("2006-01-02 15:04"), in)
+	}
+
+	// "i natt" in the morning is the night that just ended
+	p.Now = func() time.Time {
+		return time.Date(2024, time.April, 17, 7, 0, 0, 0, time.UTC)
+	}
+	res, err := p.ParseTime("i natt")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-04-16 22:00", res.Format("2006-01-02 15:04"))
+
+	i, err := p.ParseDayPart("i natt")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-04-16 22:00 - 2024-04-17 06:00", i.String())
+}
@@ -0,0 +1,124 @@
+package natural
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DayPart is a part of the day, such as the morning or the evening
+type DayPart int
+
+const (
+	// DayPartMorning is "morgon", "morning"
+	DayPartMorning DayPart = iota
+	// DayPartForenoon is "förmiddag", "forenoon"
+	DayPartForenoon
+	// DayPartLunch is "lunch"
+	DayPartLunch
+	// DayPartAfternoon is "eftermiddag", "afternoon"
+	DayPartAfternoon
+	// DayPartEvening is "kväll", "evening"
+	DayPartEvening
+	// DayPartNight is "natt", "night", ending the morning after
+	DayPartNight
+)
+
+var (
+	// DefaultDayParts holds the hours of each day part, used unless the parser overrides them
+	DefaultDayParts = map[DayPart]ClockRange{
+		DayPartMorning:   {Start: 6 * 60, End: 10 * 60},
+		DayPartForenoon:  {Start: 9 * 60, End: 12 * 60},
+		DayPartLunch:     {Start: 11 * 60, End: 13 * 60},
+		DayPartAfternoon: {Start: 12 * 60, End: 18 * 60},
+		DayPartEvening:   {Start: 18 * 60, End: 22 * 60},
+		DayPartNight:     {Start: 22 * 60, End: 30 * 60},
+	}
+
+	dayPartWords = map[string]DayPart{
+		// swe
+		"morgon": DayPartMorning, "morgonen": DayPartMorning, "morse": DayPartMorning, "imorse": DayPartMorning,
+		"förmiddag": DayPartForenoon, "förmiddagen": DayPartForenoon,
+		"lunch": DayPartLunch, "lunchen": DayPartLunch, "lunchtid": DayPartLunch,
+		"eftermiddag": DayPartAfternoon, "eftermiddagen": DayPartAfternoon,
+		"kväll": DayPartEvening, "kvällen": DayPartEvening, "ikväll": DayPartEvening,
+		"natt": DayPartNight, "natten": DayPartNight, "inatt": DayPartNight,
+		// eng
+		"morning": DayPartMorning, "forenoon": DayPartForenoon,
+		"lunchtime": DayPartLunch, "afternoon": DayPartAfternoon,
+		"evening": DayPartEvening, "tonight": DayPartEvening, "night": DayPartNight,
+	}
+
+	// words between the day and the day part, "fredag på eftermiddagen", "friday in the afternoon"
+	dayPartPrefixes = []string{"på", "i", "under", "vid", "in the", "during the", "at", "this", "on"}
+)
+
+// ParseDayPart parses a part of the day relative to the current time, see Parser.ParseDayPart
+func ParseDayPart(s string) (Interval, error) {
+	return NewParser(LocaleSvSE).ParseDayPart(s)
+}
+
+// ParseDayPart parses day parts like "på morgonen", "i kväll", "tonight" or "in the
+// afternoon" into an interval of today, or of the day given before it, as in "imorgon
+// kväll", "fredag eftermiddag" or "friday in the afternoon". "i natt" before noon is the
+// night that just ended, later it is the coming night. The hours of each day part are
+// taken from the parser DayParts, or DefaultDayParts
+func (p *Parser) ParseDayPart(s string) (Interval, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		return Interval{}, fmt.Errorf("Cannot parse day part: %s", s)
+	}
+	dp, ok := dayPartWords[words[len(words)-1]]
+	if !ok {
+		return Interval{}, fmt.Errorf("Cannot parse day part: %s", s)
+	}
+
+	rest := strings.Join(words[:len(words)-1], " ")
+	for _, prefix := range dayPartPrefixes {
+		if rest == prefix {
+			rest = ""
+		}
+		rest = strings.TrimSuffix(rest, " "+prefix)
+	}
+
+	now := p.now()
+	day := beginningOfDay(now)
+	if rest == "last" {
+		// "last night"
+		rest = "yesterday"
+	}
+	if rest == "" && dp == DayPartNight && now.Hour() < 12 {
+		// "i natt" in the morning is the night that just ended
+		day = addDay(now, -1)
+	}
+	if rest != "" {
+		// the day must be a whole day, "sex på kvällen" is a time of day
+		t, err := p.ParseTime(rest)
+		if err != nil {
+			return Interval{}, err
+		}
+		if !t.Equal(beginningOfDay(t)) {
+			return Interval{}, fmt.Errorf("Cannot parse day part: %s", s)
+		}
+		day = t
+	}
+	return dayPartInterval(day, p.dayPart(dp)), nil
+}
+
+// dayPart returns the hours of dp, from the parser or the defaults
+func (p *Parser) dayPart(dp DayPart) ClockRange {
+	if r, ok := p.DayParts[dp]; ok {
+		return r
+	}
+	return DefaultDayParts[dp]
+}
+
+// dayPartInterval returns the hours of r on the day of t
+func dayPartInterval(t time.Time, r ClockRange) Interval {
+	year, month, day := t.Date()
+	return Interval{
+		Start: time.Date(year, month, day, 0, r.Start, 0, 0, t.Location()),
+		End:   time.Date(year, month, day, 0, r.End, 0, 0, t.Location()),
+	}
+}
@@ -0,0 +1,80 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDayPart(t *testing.T) {
+	// the test parser is at wednesday 2024-04-17 15:30
+	expected := map[string]string{
+		// swe
+		"på morgonen":           "2024-04-17 06:00 - 2024-04-17 10:00",
+		"förmiddag":             "2024-04-17 09:00 - 2024-04-17 12:00",
+		"vid lunch":             "2024-04-17 11:00 - 2024-04-17 13:00",
+		"i eftermiddag":         "2024-04-17 12:00 - 2024-04-17 18:00",
+		"i kväll":               "2024-04-17 18:00 - 2024-04-17 22:00",
+		"ikväll":                "2024-04-17 18:00 - 2024-04-17 22:00",
+		"natt":                  "2024-04-17 22:00 - 2024-04-18 06:00",
+		"imorgon kväll":         "2024-04-18 18:00 - 2024-04-18 22:00",
+		"igår kväll":            "2024-04-16 18:00 - 2024-04-16 22:00",
+		"fredag eftermiddag":    "2024-04-19 12:00 - 2024-04-19 18:00",
+		"på fredag eftermiddag": "2024-04-19 12:00 - 2024-04-19 18:00",
+		"fredag på natten":      "2024-04-19 22:00 - 2024-04-20 06:00",
+		"3 maj på morgonen":     "2024-05-03 06:00 - 2024-05-03 10:00",
+		// eng
+		"this morning":            "2024-04-17 06:00 - 2024-04-17 10:00",
+		"in the afternoon":        "2024-04-17 12:00 - 2024-04-17 18:00",
+		"tonight":                 "2024-04-17 18:00 - 2024-04-17 22:00",
+		"Friday in the afternoon": "2024-04-19 12:00 - 2024-04-19 18:00",
+		"friday night":            "2024-04-19 22:00 - 2024-04-20 06:00",
+		"at night":                "2024-04-17 22:00 - 2024-04-18 06:00",
+	}
+	p := testParser(LocaleSvSE)
+	for in, exp := range expected {
+		res, err := p.ParseDayPart(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, res.String(), in)
+	}
+
+	// clock times are not days
+	for _, in := range []string{"", "sex på kvällen", "halv elva på kvällen", "kväll och natt", "imorgon"} {
+		_, err := p.ParseDayPart(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
+
+func TestParseDayPartHours(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	p.DayParts = map[DayPart]ClockRange{
+		DayPartEvening: {Start: 17 * 60, End: 23*60 + 30},
+	}
+	res, err := p.ParseDayPart("imorgon kväll")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-04-18 17:00 - 2024-04-18 23:30", res.String())
+
+	// the others keep their default hours
+	res, err = p.ParseDayPart("på morgonen")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-04-17 06:00 - 2024-04-17 10:00", res.String())
+}
+
+func TestParseTimeDayPart(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	expected := map[string]time.Time{
+		"på morgonen":        time.Date(2024, time.April, 17, 6, 0, 0, 0, time.UTC),
+		"imorgon kväll":      time.Date(2024, time.April, 18, 18, 0, 0, 0, time.UTC),
+		"fredag eftermiddag": time.Date(2024, time.April, 19, 12, 0, 0, 0, time.UTC),
+		"natt":               time.Date(2024, time.April, 17, 22, 0, 0, 0, time.UTC),
+		"sex på kvällen":     time.Date(2024, time.April, 17, 18, 0, 0, 0, time.UTC),
+		"midnatt":            time.Date(2024, time.April, 17, 0, 0, 0, 0, time.UTC),
+		"lunch":              time.Date(2024, time.April, 17, 12, 0, 0, 0, time.UTC),
+	}
+	for in, exp := range expected {
+		res, err := p.ParseTime(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, res, in)
+	}
+}
@@ -0,0 +1,356 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// "80-talet", "1800-talet", "nittiotalet", "artonhundratalet"
+	swedishDecadeRegex = regexp.MustCompile(`^(\d{1,4}|\pL+?)-?talet$`)
+
+	// "80s", "'80s", "1980s", "1800s"
+	englishDecadeRegex = regexp.MustCompile(`^'?(\d{1,4})'?s$`)
+
+	// "the eighties", "the nineteen hundreds", "the nineteen twenties"
+	englishSpelledDecadeRegex = regexp.MustCompile(`^(\pL+)ies$|^(.+) hundreds$|^(\pL+) (\pL+)ies$`)
+
+	// "1900-talets första decennium", "the first decade of the 1900s"
+	firstDecadeRegex = regexp.MustCompile(`^(?:(.+)s första decenniet|(.+)s första decennium|first decade of (?:the )?(.+))$`)
+
+	// "the 19th century", "det nittonde århundradet", "tjugoförsta seklet"
+	centuryRegex = regexp.MustCompile(`^(?:det )?(\S+) (century|århundradet|seklet)$`)
+
+	// era markers, true if the year is before Christ
+	eraSuffixes = map[string]bool{
+		// swe
+		"f.kr.": true, "f.kr": true, "fkr": true, "före kristus": true,
+		"f.v.t.": true, "fvt": true, "före vår tideräkning": true,
+		"e.kr.": false, "e.kr": false, "ekr": false, "efter kristus": false,
+		"e.v.t.": false, "evt": false, "efter vår tideräkning": false,
+		// eng
+		"bc": true, "b.c.": true, "bce": true, "b.c.e.": true,
+		"ad": false, "a.d.": false, "ce": false, "c.e.": false,
+	}
+
+	// era markers written before the year, "AD 800"
+	eraPrefixes = []string{"ad ", "a.d. "}
+
+	// decades without a number
+	decadeNames = map[string]int{
+		"nollnolltalet": 2000, "noughties": 2000,
+	}
+
+	// the years 1-9 in an era, "första decenniet e.Kr."
+	zeroDecadeNames = []string{"första decenniet", "first decade"}
+)
+
+// ParseEra parses a decade, century or year in an era relative to the current
+// time, see Parser.ParseEra
+func ParseEra(s string) (Interval, error) {
+	return NewParser(LocaleSvSE).ParseEra(s)
+}
+
+// ParseEra parses decades like "80-talet", "nittiotalet", "the nineties" or
+// "1990s", centuries like "1800-talet", "artonhundratalet", "the 19th century" or
+// "the 1800s" and years with an era like "200 f.Kr.", "44 BC" or "AD 800" into an
+// interval of whole years. The years 1-9 are "första decenniet e.Kr." or "the
+// first decade AD". Two digit decades are placed as in ParseYear. A
+// century given by its number starts at the even hundred, so "the 19th century"
+// is 1800-1899, but "the 5th century BC" is 500-401 BC. Years before Christ use
+// the proleptic Gregorian calendar with astronomical numbering: there is no year
+// zero, so 1 BC is year 0 and 200 f.Kr. is year -199
+func (p *Parser) ParseEra(s string) (Interval, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimPrefix(s, "the ")
+	loc := p.now().Location()
+
+	rest, bc, ok := splitEra(s)
+	if ok && isNumericString(rest) {
+		// "200 f.kr."
+		year, err := strconv.Atoi(rest)
+		if err != nil || year < 1 {
+			return Interval{}, fmt.Errorf("Cannot parse era: %s", s)
+		}
+		return eraInterval(year, 1, bc, loc), nil
+	}
+	rest = strings.TrimPrefix(rest, "the ")
+	for _, name := range zeroDecadeNames {
+		if rest == name {
+			return eraInterval(0, 10, bc, loc), nil
+		}
+	}
+
+	firstDecade := false
+	if match := firstDecadeRegex.FindStringSubmatch(rest); match != nil {
+		rest, firstDecade = strings.TrimPrefix(match[1]+match[2]+match[3], "the "), true
+	}
+
+	if year, found := decadeNames[rest]; found && !ok {
+		return eraInterval(year, 10, false, loc), nil
+	}
+
+	if match := centuryRegex.FindStringSubmatch(rest); match != nil {
+		n, err := parseOrdinal(match[1])
+		if parts := strings.SplitN(match[1], "-", 2); len(parts) == 2 {
+			// "twenty-first"
+			var tens decimal.Decimal
+			if tens, err = ParseNumber(parts[0]); err == nil {
+				n, err = parseOrdinal(parts[1])
+				n += int(tens.IntPart())
+			}
+		}
+		if err != nil || n < 1 {
+			return Interval{}, fmt.Errorf("Cannot parse century: %s", s)
+		}
+		switch {
+		case firstDecade && bc:
+			return Interval{}, fmt.Errorf("Cannot parse decade: %s", s)
+		case firstDecade:
+			return eraInterval((n-1)*100, 10, false, loc), nil
+		case bc:
+			// the 5th century BC is 500-401 BC
+			return eraInterval((n-1)*100+1, 100, true, loc), nil
+		}
+		return eraInterval((n-1)*100, 100, false, loc), nil
+	}
+
+	// width is the number of digits, zero when spelled out
+	label, width, english := 0, 0, false
+	if match := swedishDecadeRegex.FindStringSubmatch(rest); match != nil {
+		n, err := ParseNumber(match[1])
+		if err != nil {
+			return Interval{}, fmt.Errorf("Cannot parse era: %s", s)
+		}
+		label = int(n.IntPart())
+		if isNumericString(match[1]) {
+			width = len(match[1])
+		}
+	} else if match := englishDecadeRegex.FindStringSubmatch(rest); match != nil {
+		label, _ = strconv.Atoi(match[1])
+		width, english = len(match[1]), true
+	} else if match := englishSpelledDecadeRegex.FindStringSubmatch(rest); match != nil {
+		if match[1] != "" {
+			// "eighties" => "eighty"
+			n, err := ParseNumber(match[1] + "y")
+			if err != nil {
+				return Interval{}, fmt.Errorf("Cannot parse era: %s", s)
+			}
+			label = int(n.IntPart())
+		} else if match[2] != "" {
+			// "nineteen hundreds"
+			n, err := ParseNumber(match[2])
+			if err != nil {
+				return Interval{}, fmt.Errorf("Cannot parse era: %s", s)
+			}
+			label = int(n.IntPart()) * 100
+		} else {
+			// "nineteen twenties"
+			hundreds, err := ParseNumber(match[3])
+			if err != nil {
+				return Interval{}, fmt.Errorf("Cannot parse era: %s", s)
+			}
+			tens, err := ParseNumber(match[4] + "y")
+			if err != nil {
+				return Interval{}, fmt.Errorf("Cannot parse era: %s", s)
+			}
+			label = int(hundreds.IntPart())*100 + int(tens.IntPart())
+		}
+	} else {
+		return Interval{}, fmt.Errorf("Cannot parse era: %s", s)
+	}
+
+	if label%10 != 0 {
+		return Interval{}, fmt.Errorf("Invalid decade: %s", s)
+	}
+	century := label >= 100 && label%100 == 0 && !(english && label%1000 == 0)
+	if firstDecade && !century {
+		return Interval{}, fmt.Errorf("Cannot parse decade: %s", s)
+	}
+	if label < 100 && !ok && width <= 2 {
+		// "80-talet", "the nineties"
+		year, err := ParseYear(fmt.Sprintf("%02d", label))
+		if err != nil {
+			return Interval{}, err
+		}
+		return eraInterval(year, 10, false, loc), nil
+	}
+
+	// "1800-talet" is a century, but "the 2000s" is a decade in english
+	if century && !firstDecade {
+		return eraInterval(label, 100, bc, loc), nil
+	}
+	return eraInterval(label, 10, bc, loc), nil
+}
+
+// splitEra removes an era marker, returning the rest and whether the marker is
+// before Christ
+func splitEra(s string) (string, bool, bool) {
+	for _, prefix := range eraPrefixes {
+		if strings.HasPrefix(s, prefix) {
+			return strings.TrimSpace(s[len(prefix):]), false, true
+		}
+	}
+	for suffix, bc := range eraSuffixes {
+		if strings.HasSuffix(s, " "+suffix) {
+			return strings.TrimSpace(strings.TrimSuffix(s, suffix)), bc, true
+		}
+	}
+	return s, false, false
+}
+
+// eraYear returns the astronomical year of year in an era, 1 BC is year 0
+func eraYear(year int, bc bool) int {
+	if bc {
+		return 1 - year
+	}
+	return year
+}
+
+// eraInterval returns the years first to first+years-1 counted in an era. Before
+// Christ the years are counted backwards, so 400-talet f.Kr. is 499-400 BC. There
+// is no year zero, so the first century is 1-99
+func eraInterval(first, years int, bc bool, loc *time.Location) Interval {
+	if first < 1 {
+		years, first = years-(1-first), 1
+	}
+	start := eraYear(first, bc)
+	if bc {
+		start = eraYear(first+years-1, true)
+	}
+	return monthInterval(start, time.January, 12*years, loc)
+}
+
+// eraLabel returns year counted in its era, rounded down to size years, and
+// whether it is before Christ
+func eraLabel(year, size int) (int, bool) {
+	if year < 1 {
+		return (1 - year) / size * size, true
+	}
+	return year / size * size, false
+}
+
+// eraSuffix returns the marker after years before Christ, such as " f.Kr.", and
+// after years after Christ if ad is set, such as " e.Kr."
+func eraSuffix(bc, ad bool, locale string) string {
+	switch {
+	case bc && locale == LocaleSvSE:
+		return " f.Kr."
+	case bc:
+		return " BC"
+	case ad && locale == LocaleSvSE:
+		return " e.Kr."
+	case ad:
+		return " AD"
+	}
+	return ""
+}
+
+// PresentYear returns the year of t, with an era for years before Christ and
+// years before 100, such as "2024", "200 f.Kr.", "44 BC" or "50 e.Kr."
+func PresentYear(t time.Time, locale string) string {
+	year, bc := eraLabel(t.Year(), 1)
+	return strconv.Itoa(year) + eraSuffix(bc, year < 100, locale)
+}
+
+// PresentDecade returns the decade of t, such as "80-talet" or "the 80s" (DateShort),
+// "1980-talet" or "the 1980s" (DateLong) and "åttiotalet" or "the eighties"
+// (DateSpelledOut). The short and spelled two digit forms are only used where
+// ParseYear reads the two digits back as the same decade, other decades from 1100
+// are spelled in full, "nittonhundratjugotalet" or "the nineteen twenties", and
+// the rest are written in digits. Decades starting a century are "1900-talets
+// första decennium" or "the first decade of the 1900s". Decades before 100 have
+// an era, "50-talet e.Kr." or "the 50s AD", and the years 1-9 are "första
+// decenniet e.Kr." or "the first decade AD"
+func PresentDecade(t time.Time, locale string, style DateStyle) string {
+	decade, bc := eraLabel(t.Year(), 10)
+	suffix := eraSuffix(bc, decade < 100, locale)
+	short := false
+	if decade >= 1900 && decade%100 != 0 && !bc {
+		year, err := ParseYear(fmt.Sprintf("%02d", decade%100))
+		short = err == nil && year == decade
+	}
+	spelled := style&DateSpelledOut != 0
+	sv := locale == LocaleSvSE
+	full := spelled && decade >= 1100 && decade%100 != 0 && !bc
+
+	switch {
+	case decade == 0 && sv:
+		return "första decenniet" + suffix
+	case decade == 0:
+		return "the first decade" + suffix
+	case spelled && decade == 2000 && !bc && sv:
+		return "nollnolltalet"
+	case spelled && decade == 2000 && !bc:
+		return "the noughties"
+	case spelled && short && sv:
+		return PresentSvSE(int64(decade%100)) + "talet"
+	case spelled && short && decade%100 >= 20:
+		// "eighty" => "eighties", "the tens" is not said
+		return "the " + strings.TrimSuffix(PresentEnUS(int64(decade%100)), "y") + "ies"
+	case full && sv:
+		return presentYearSvSE(decade) + "talet"
+	case full && decade%100 >= 20:
+		// "nineteen twenty" => "nineteen twenties"
+		return "the " + strings.TrimSuffix(presentYearEnUS(decade), "y") + "ies"
+	}
+
+	s := strconv.Itoa(decade)
+	if short && style&dateBaseStyleMask == DateShort {
+		s = fmt.Sprintf("%02d", decade%100)
+	}
+	// "1900-talet" and "the 1900s" are centuries
+	first := decade >= 100 && decade%100 == 0
+	switch {
+	case sv && first:
+		return s + "-talets första decennium" + suffix
+	case sv:
+		return s + "-talet" + suffix
+	case first && decade%1000 != 0:
+		return "the first decade of the " + s + "s" + suffix
+	}
+	return "the " + s + "s" + suffix
+}
+
+// PresentCentury returns the century of t, such as "1800-talet" or "the 19th
+// century", spelled out as "artonhundratalet" or "the nineteenth century" with
+// DateSpelledOut. The years 1-99 are "första århundradet e.Kr." or "the 1st
+// century AD"
+func PresentCentury(t time.Time, locale string, style DateStyle) string {
+	spelled := style&DateSpelledOut != 0
+	if locale == LocaleSvSE {
+		century, bc := eraLabel(t.Year(), 100)
+		suffix := eraSuffix(bc, false, locale)
+		switch {
+		case century == 0:
+			// "0-talet" would be a decade
+			return "första århundradet" + eraSuffix(bc, true, locale)
+		case !spelled:
+			return strconv.Itoa(century) + "-talet" + suffix
+		case century >= 2000:
+			// "tvåtusentalet", "tvåtusenetthundratalet"
+			return strings.Replace(PresentSvSE(int64(century)), " ", "", -1) + "talet" + suffix
+		}
+		return PresentSvSE(int64(century/100)) + "hundratalet" + suffix
+	}
+
+	// the 19th century is 1800-1899, but the 5th century BC is 500-401 BC
+	n, bc := t.Year()/100+1, false
+	if t.Year() < 1 {
+		n, bc = (-t.Year())/100+1, true
+	}
+	suffix := eraSuffix(bc, n == 1, locale)
+	switch {
+	case spelled && n > 20 && n < 100 && n%10 != 0:
+		// "twenty-first"
+		return "the " + PresentEnUS(int64(n-n%10)) + "-" + PresentCountEnglish(n%10) + " century" + suffix
+	case spelled && n < 100:
+		return "the " + PresentCountEnglish(n) + " century" + suffix
+	}
+	return "the " + ordinalDigitsEnUS(n) + " century" + suffix
+}
@@ -0,0 +1,178 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEra(t *testing.T) {
+	expected := map[string]string{
+		// swe
+		"80-talet":                     "1980-01-01 00:00 - 1990-01-01 00:00",
+		"1980-talet":                   "1980-01-01 00:00 - 1990-01-01 00:00",
+		"nittiotalet":                  "1990-01-01 00:00 - 2000-01-01 00:00",
+		"tiotalet":                     "2010-01-01 00:00 - 2020-01-01 00:00",
+		"nollnolltalet":                "2000-01-01 00:00 - 2010-01-01 00:00",
+		"1800-talet":                   "1800-01-01 00:00 - 1900-01-01 00:00",
+		"2000-talet":                   "2000-01-01 00:00 - 2100-01-01 00:00",
+		"artonhundratalet":             "1800-01-01 00:00 - 1900-01-01 00:00",
+		"nittonde seklet":              "1800-01-01 00:00 - 1900-01-01 00:00",
+		"200 f.Kr.":                    "-0199-01-01 00:00 - -0198-01-01 00:00",
+		"1 f.Kr.":                      "0000-01-01 00:00 - 0001-01-01 00:00",
+		"800 e.Kr.":                    "0800-01-01 00:00 - 0801-01-01 00:00",
+		"400-talet f.Kr.":              "-0498-01-01 00:00 - -0398-01-01 00:00",
+		"1900-talets första decennium": "1900-01-01 00:00 - 1910-01-01 00:00",
+		"50-talet e.Kr.":               "0050-01-01 00:00 - 0060-01-01 00:00",
+		"första århundradet e.Kr.":     "0001-01-01 00:00 - 0100-01-01 00:00",
+		"första decenniet e.Kr.":       "0001-01-01 00:00 - 0010-01-01 00:00",
+		"nittonhundratjugotalet":       "1920-01-01 00:00 - 1930-01-01 00:00",
+		// eng
+		"the 80s":                       "1980-01-01 00:00 - 1990-01-01 00:00",
+		"the '80s":                      "1980-01-01 00:00 - 1990-01-01 00:00",
+		"the nineties":                  "1990-01-01 00:00 - 2000-01-01 00:00",
+		"1990s":                         "1990-01-01 00:00 - 2000-01-01 00:00",
+		"the 2000s":                     "2000-01-01 00:00 - 2010-01-01 00:00",
+		"the 1800s":                     "1800-01-01 00:00 - 1900-01-01 00:00",
+		"the nineteen hundreds":         "1900-01-01 00:00 - 2000-01-01 00:00",
+		"the 19th century":              "1800-01-01 00:00 - 1900-01-01 00:00",
+		"the twenty-first century":      "2000-01-01 00:00 - 2100-01-01 00:00",
+		"the 5th century BC":            "-0499-01-01 00:00 - -0399-01-01 00:00",
+		"44 BC":                         "-0043-01-01 00:00 - -0042-01-01 00:00",
+		"AD 800":                        "0800-01-01 00:00 - 0801-01-01 00:00",
+		"the first decade of the 1900s": "1900-01-01 00:00 - 1910-01-01 00:00",
+		"the 1st century":               "0001-01-01 00:00 - 0100-01-01 00:00",
+		"the first decade BC":           "-0008-01-01 00:00 - 0001-01-01 00:00",
+		"the nineteen twenties":         "1920-01-01 00:00 - 1930-01-01 00:00",
+	}
+	p := testParser(LocaleSvSE)
+	for in, exp := range expected {
+		i, err := p.ParseEra(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, i.String(), in)
+	}
+
+	for _, in := range []string{"", "85-talet", "0 f.Kr.", "the fives", "äpplet"} {
+		_, err := p.ParseEra(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
+
+func TestParsePeriodEra(t *testing.T) {
+	expected := map[string]string{
+		"i början av 1900-talet": "1900-01-01 00:00 - 1933-05-01 00:00",
+		"slutet av 80-talet":     "1986-09-01 00:00 - 1990-01-01 00:00",
+		"early 2000s":            "2000-01-01 00:00 - 2003-05-01 00:00",
+		"mid-90s":                "1993-05-01 00:00 - 1996-09-01 00:00",
+		"200 f.Kr.":              "-0199-01-01 00:00 - -0198-01-01 00:00",
+	}
+	p := testParser(LocaleSvSE)
+	for in, exp := range expected {
+		i, err := p.ParsePeriod(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, i.String(), in)
+	}
+}
+
+func TestParseYearEra(t *testing.T) {
+	expected := map[string]int{
+		"200 f.Kr.": -199,
+		"1 BC":      0,
+		"44 BC":     -43,
+		"AD 800":    800,
+		"800 e.Kr.": 800,
+		"1066 CE":   1066,
+	}
+	for s, i := range expected {
+		y, err := ParseYear(s)
+		assert.Equal(t, nil, err, s)
+		assert.Equal(t, i, y, s)
+	}
+
+	_, err := ParseYear("0 f.Kr.")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestPresentEra(t *testing.T) {
+	tm := time.Date(1984, time.June, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "1984", PresentYear(tm, LocaleSvSE))
+	assert.Equal(t, "80-talet", PresentDecade(tm, LocaleSvSE, DateShort))
+	assert.Equal(t, "1980-talet", PresentDecade(tm, LocaleSvSE, DateLong))
+	assert.Equal(t, "åttiotalet", PresentDecade(tm, LocaleSvSE, DateLong|DateSpelledOut))
+	assert.Equal(t, "the 80s", PresentDecade(tm, LocaleEnUS, DateShort))
+	assert.Equal(t, "the 1980s", PresentDecade(tm, LocaleEnUS, DateLong))
+	assert.Equal(t, "the eighties", PresentDecade(tm, LocaleEnUS, DateLong|DateSpelledOut))
+	assert.Equal(t, "1900-talet", PresentCentury(tm, LocaleSvSE, DateLong))
+	assert.Equal(t, "nittonhundratalet", PresentCentury(tm, LocaleSvSE, DateLong|DateSpelledOut))
+	assert.Equal(t, "the 20th century", PresentCentury(tm, LocaleEnUS, DateLong))
+	assert.Equal(t, "the twentieth century", PresentCentury(tm, LocaleEnUS, DateLong|DateSpelledOut))
+
+	bc := time.Date(-199, time.January, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "200 f.Kr.", PresentYear(bc, LocaleSvSE))
+	assert.Equal(t, "200 BC", PresentYear(bc, LocaleEnUS))
+	assert.Equal(t, "200-talet f.Kr.", PresentCentury(bc, LocaleSvSE, DateLong))
+	assert.Equal(t, "the 2nd century BC", PresentCentury(bc, LocaleEnUS, DateLong))
+	assert.Equal(t, "the 1st century BC", PresentCentury(time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC), LocaleEnUS, DateLong))
+	assert.Equal(t, "tvåtusentalet", PresentCentury(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), LocaleSvSE, DateSpelledOut))
+	assert.Equal(t, "the twenty-first century", PresentCentury(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), LocaleEnUS, DateSpelledOut))
+
+	// decades starting a century, and years before 100
+	tm = time.Date(1905, time.June, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "1900-talets första decennium", PresentDecade(tm, LocaleSvSE, DateLong))
+	assert.Equal(t, "the first decade of the 1900s", PresentDecade(tm, LocaleEnUS, DateLong))
+	tm = time.Date(2005, time.June, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "nollnolltalet", PresentDecade(tm, LocaleSvSE, DateSpelledOut))
+	assert.Equal(t, "the 2000s", PresentDecade(tm, LocaleEnUS, DateLong))
+	tm = time.Date(50, time.June, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "50 e.Kr.", PresentYear(tm, LocaleSvSE))
+	assert.Equal(t, "50-talet e.Kr.", PresentDecade(tm, LocaleSvSE, DateLong))
+	assert.Equal(t, "första århundradet e.Kr.", PresentCentury(tm, LocaleSvSE, DateLong))
+	assert.Equal(t, "50 AD", PresentYear(tm, LocaleEnUS))
+	assert.Equal(t, "the 50s AD", PresentDecade(tm, LocaleEnUS, DateLong))
+	assert.Equal(t, "the 1st century AD", PresentCentury(tm, LocaleEnUS, DateLong))
+	assert.Equal(t, "the first century AD", PresentCentury(tm, LocaleEnUS, DateSpelledOut))
+
+	// the years 1-9 and 9-1 BC
+	tm = time.Date(1, time.June, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "första decenniet e.Kr.", PresentDecade(tm, LocaleSvSE, DateLong))
+	assert.Equal(t, "the first decade AD", PresentDecade(tm, LocaleEnUS, DateLong))
+	tm = time.Date(0, time.June, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "första decenniet f.Kr.", PresentDecade(tm, LocaleSvSE, DateLong))
+	assert.Equal(t, "the first decade BC", PresentDecade(tm, LocaleEnUS, DateLong))
+
+	// "tjugotalet" is the 2020s, so the 1920s are spelled in full
+	tm = time.Date(1925, time.June, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "nittonhundratjugotalet", PresentDecade(tm, LocaleSvSE, DateSpelledOut))
+	assert.Equal(t, "the nineteen twenties", PresentDecade(tm, LocaleEnUS, DateSpelledOut))
+}
+
+func TestPresentEraParses(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	years := []int{-99, -5, 0, 1, 5, 100, 1925}
+	for year := -1205; year <= 2105; year += 10 {
+		years = append(years, year)
+	}
+	for _, year := range years {
+		tm := time.Date(year, time.June, 1, 0, 0, 0, 0, time.UTC)
+		for _, locale := range []string{LocaleSvSE, LocaleEnUS} {
+			y, err := ParseYear(PresentYear(tm, locale))
+			assert.Equal(t, nil, err, year)
+			assert.Equal(t, year, y)
+
+			for _, style := range []DateStyle{DateShort, DateLong, DateLong | DateSpelledOut} {
+				s := PresentDecade(tm, locale, style)
+				i, err := p.ParseEra(s)
+				assert.Equal(t, nil, err, s)
+				assert.True(t, i.Contains(tm), s)
+				assert.Contains(t, []int{9, 10}, i.End.Year()-i.Start.Year(), s)
+
+				s = PresentCentury(tm, locale, style)
+				i, err = p.ParseEra(s)
+				assert.Equal(t, nil, err, s)
+				assert.True(t, i.Contains(tm), s)
+				assert.Contains(t, []int{99, 100}, i.End.Year()-i.Start.Year(), s)
+			}
+		}
+	}
+}
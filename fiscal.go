@@ -0,0 +1,189 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// "FY25", "fiscal year 2025", "räkenskapsåret 2024/25", before or after the part
+	fiscalYearRegex = regexp.MustCompile(`^(?:(.+?),? (?:of |i )?)?(?:fy|fiscal year|fiscal|räkenskapsåret|räkenskapsår|budgetåret) ?(\d{2}|\d{4})(?:/(\d{2}|\d{4}))?(?: (.+))?$`)
+
+	// "Q3 2024", "tredje kvartalet i 2024", "the first half of 2024", "Q3 -24"
+	periodYearSuffixRegex = regexp.MustCompile(`^(.+?),? (?:of |i )?(?:(\d{4})|-(\d{2}))$`)
+
+	// "2024 Q3", "2024-Q3"
+	periodYearPrefixRegex = regexp.MustCompile(`^(\d{4})[ -](.+)$`)
+
+	// "Q3", "H1", "T2"
+	periodShortRegex = regexp.MustCompile(`^([qht])([1-4])$`)
+
+	// "tredje kvartalet", "första halvåret", "third quarter", "sista tertialet"
+	periodOrdinalRegex = regexp.MustCompile(`^(\S+) (kvartalet|halvåret|tertialet|quarter|half|tertial)$`)
+
+	// "kvartal 3", "halvår 1", "quarter 2", "tertial 2"
+	periodNumberRegex = regexp.MustCompile(`^(kvartal|halvår|tertial|quarter|half) (\d)$`)
+
+	// months in each kind of period
+	periodPartMonths = map[string]int{
+		"q": 3, "kvartalet": 3, "kvartal": 3, "quarter": 3,
+		"h": 6, "halvåret": 6, "halvår": 6, "half": 6,
+		"t": 4, "tertialet": 4, "tertial": 4,
+	}
+
+	// years relative to the current one, "tredje kvartalet i fjol"
+	periodRelativeYears = map[string]int{
+		"i fjol": -1, "förra året": -1, "i år": 0, "nästa år": 1,
+		"last year": -1, "this year": 0, "next year": 1,
+	}
+)
+
+// ParseFiscalPeriod parses a quarter, half or tertial relative to the current time,
+// see Parser.ParseFiscalPeriod
+func ParseFiscalPeriod(s string) (Interval, error) {
+	return NewParser(LocaleSvSE).ParseFiscalPeriod(s)
+}
+
+// ParseFiscalPeriod parses quarters, halves, tertials and fiscal years like "Q3",
+// "Q3 2024", "tredje kvartalet i fjol", "första halvåret", "tertial 2", "the second
+// half of 2024", "FY25" or "FY25 H1". Periods are counted from the parser
+// FiscalYearStart. A fiscal year not starting in January is named by the year it
+// ends in, so with a start in July "FY25" and "Q1 2025" begin in July 2024
+func (p *Parser) ParseFiscalPeriod(s string) (Interval, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimPrefix(s, "the ")
+	now := p.now()
+	year := p.fiscalYear(now)
+
+	if s == "" {
+		return Interval{}, fmt.Errorf("Cannot parse fiscal period: %s", s)
+	}
+
+	part := s
+	if match := fiscalYearRegex.FindStringSubmatch(s); match != nil {
+		named := match[2]
+		if match[3] != "" {
+			// "2024/25" is named by the year it ends in
+			named = match[3]
+		}
+		y, err := ParseYear(named)
+		if err != nil {
+			return Interval{}, err
+		}
+		year = y
+		part = strings.TrimSpace(match[1] + " " + match[4])
+	} else if match := periodYearSuffixRegex.FindStringSubmatch(s); match != nil {
+		y, err := ParseYear(match[2] + match[3])
+		if err != nil {
+			return Interval{}, err
+		}
+		year, part = y, match[1]
+	} else if match := periodYearPrefixRegex.FindStringSubmatch(s); match != nil {
+		y, err := ParseYear(match[1])
+		if err != nil {
+			return Interval{}, err
+		}
+		year, part = y, match[2]
+	} else {
+		for suffix, diff := range periodRelativeYears {
+			if strings.HasSuffix(s, " "+suffix) {
+				// "third quarter of last year"
+				year, part = year+diff, strings.TrimSuffix(strings.TrimSuffix(s, " "+suffix), " of")
+				break
+			}
+		}
+	}
+	part = strings.TrimPrefix(part, "the ")
+
+	start := p.fiscalYearStart(year, now.Location())
+	if part == "" {
+		// "FY25"
+		return Interval{Start: start, End: start.AddDate(1, 0, 0)}, nil
+	}
+
+	kind, n := "", 0
+	if match := periodShortRegex.FindStringSubmatch(part); match != nil {
+		kind = match[1]
+		n, _ = strconv.Atoi(match[2])
+	} else if match := periodOrdinalRegex.FindStringSubmatch(part); match != nil {
+		var err error
+		if n, err = parseOrdinal(match[1]); err != nil {
+			return Interval{}, err
+		}
+		kind = match[2]
+	} else if match := periodNumberRegex.FindStringSubmatch(part); match != nil {
+		kind = match[1]
+		n, _ = strconv.Atoi(match[2])
+	} else {
+		return Interval{}, fmt.Errorf("Cannot parse fiscal period: %s", s)
+	}
+
+	months := periodPartMonths[kind]
+	count := 12 / months
+	if n < 0 {
+		// "sista kvartalet"
+		n += count + 1
+	}
+	if n < 1 || n > count {
+		return Interval{}, fmt.Errorf("Invalid fiscal period: %s", s)
+	}
+	start = start.AddDate(0, (n-1)*months, 0)
+	return Interval{Start: start, End: start.AddDate(0, months, 0)}, nil
+}
+
+// fiscalStart returns the first month of the fiscal year, january by default
+func (p *Parser) fiscalStart() time.Month {
+	if p.FiscalYearStart < time.January || p.FiscalYearStart > time.December {
+		return time.January
+	}
+	return p.FiscalYearStart
+}
+
+// fiscalYear returns the name of the fiscal year containing t
+func (p *Parser) fiscalYear(t time.Time) int {
+	if p.fiscalStart() == time.January || t.Month() < p.fiscalStart() {
+		return t.Year()
+	}
+	return t.Year() + 1
+}
+
+// fiscalYearStart returns the beginning of the fiscal year named year
+func (p *Parser) fiscalYearStart(year int, loc *time.Location) time.Time {
+	if p.fiscalStart() == time.January {
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	}
+	return time.Date(year-1, p.fiscalStart(), 1, 0, 0, 0, 0, loc)
+}
+
+// PresentQuarter returns the calendar quarter of t, such as "Q3" (DateShort),
+// "tredje kvartalet" or "the third quarter" (DateLong). The year is included
+// if it is not the current year, or with DateWithYear
+func PresentQuarter(t time.Time, locale string, style DateStyle) string {
+	quarter := (int(t.Month())-1)/3 + 1
+	withYear := style&DateWithYear != 0 || t.Year() != time.Now().Year()
+	short := style&dateBaseStyleMask == DateShort && style&DateSpelledOut == 0
+
+	s := ""
+	switch {
+	case short:
+		s = fmt.Sprintf("Q%d", quarter)
+		if withYear {
+			s += fmt.Sprintf(" %d", t.Year())
+		}
+		return s
+	case locale == LocaleSvSE:
+		s = PresentCountSwedish(quarter) + " kvartalet"
+		if withYear {
+			s += fmt.Sprintf(" %d", t.Year())
+		}
+		return s
+	}
+	s = "the " + PresentCountEnglish(quarter) + " quarter"
+	if withYear {
+		s += fmt.Sprintf(" of %d", t.Year())
+	}
+	return s
+}
@@ -0,0 +1,92 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFiscalPeriod(t *testing.T) {
+	// the test parser is at 2024-04-17
+	expected := map[string]string{
+		// swe
+		"tredje kvartalet":        "2024-07-01 00:00 - 2024-10-01 00:00",
+		"tredje kvartalet i fjol": "2023-07-01 00:00 - 2023-10-01 00:00",
+		"3:e kvartalet 2023":      "2023-07-01 00:00 - 2023-10-01 00:00",
+		"sista kvartalet":         "2024-10-01 00:00 - 2025-01-01 00:00",
+		"kvartal 2":               "2024-04-01 00:00 - 2024-07-01 00:00",
+		"första halvåret":         "2024-01-01 00:00 - 2024-07-01 00:00",
+		"andra halvåret nästa år": "2025-07-01 00:00 - 2026-01-01 00:00",
+		"tertial 2":               "2024-05-01 00:00 - 2024-09-01 00:00",
+		"andra tertialet":         "2024-05-01 00:00 - 2024-09-01 00:00",
+		"räkenskapsåret 2023":     "2023-01-01 00:00 - 2024-01-01 00:00",
+		// short
+		"Q3":      "2024-07-01 00:00 - 2024-10-01 00:00",
+		"Q3 2024": "2024-07-01 00:00 - 2024-10-01 00:00",
+		"Q1 -25":  "2025-01-01 00:00 - 2025-04-01 00:00",
+		"2024-Q4": "2024-10-01 00:00 - 2025-01-01 00:00",
+		"H2":      "2024-07-01 00:00 - 2025-01-01 00:00",
+		"T3 2024": "2024-09-01 00:00 - 2025-01-01 00:00",
+		"FY25":    "2025-01-01 00:00 - 2026-01-01 00:00",
+		"FY25 H1": "2025-01-01 00:00 - 2025-07-01 00:00",
+		"Q3 FY25": "2025-07-01 00:00 - 2025-10-01 00:00",
+		// eng
+		"the third quarter":          "2024-07-01 00:00 - 2024-10-01 00:00",
+		"third quarter of last year": "2023-07-01 00:00 - 2023-10-01 00:00",
+		"the second half of 2024":    "2024-07-01 00:00 - 2025-01-01 00:00",
+		"fiscal year 2025":           "2025-01-01 00:00 - 2026-01-01 00:00",
+	}
+	p := testParser(LocaleSvSE)
+	for in, exp := range expected {
+		i, err := p.ParseFiscalPeriod(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, i.String(), in)
+	}
+
+	for _, in := range []string{"", "Q5", "kvartal 5", "femte kvartalet", "H3", "tredje äpplet"} {
+		_, err := p.ParseFiscalPeriod(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
+
+func TestParseFiscalPeriodYearStart(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	p.FiscalYearStart = time.July
+
+	// the fiscal year is named by the year it ends in, april 2024 is in FY24
+	expected := map[string]string{
+		"Q1":                     "2023-07-01 00:00 - 2023-10-01 00:00",
+		"Q1 2025":                "2024-07-01 00:00 - 2024-10-01 00:00",
+		"FY25":                   "2024-07-01 00:00 - 2025-07-01 00:00",
+		"FY2025":                 "2024-07-01 00:00 - 2025-07-01 00:00",
+		"FY25 H2":                "2025-01-01 00:00 - 2025-07-01 00:00",
+		"räkenskapsåret 2024/25": "2024-07-01 00:00 - 2025-07-01 00:00",
+		"första halvåret":        "2023-07-01 00:00 - 2024-01-01 00:00",
+		"sista kvartalet":        "2024-04-01 00:00 - 2024-07-01 00:00",
+	}
+	for in, exp := range expected {
+		i, err := p.ParseFiscalPeriod(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, i.String(), in)
+	}
+
+	// ParsePeriod counts quarters from the fiscal year start
+	p.FiscalYearStart = time.February
+	i, err := p.ParsePeriod("detta kvartal")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-02-01 00:00 - 2024-05-01 00:00", i.String())
+
+	i, err = p.ParsePeriod("Q1")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-02-01 00:00 - 2024-05-01 00:00", i.String())
+}
+
+func TestPresentQuarter(t *testing.T) {
+	tm := time.Date(2024, time.August, 3, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "Q3 2024", PresentQuarter(tm, LocaleSvSE, DateShort|DateWithYear))
+	assert.Equal(t, "tredje kvartalet 2024", PresentQuarter(tm, LocaleSvSE, DateLong|DateWithYear))
+	assert.Equal(t, "the third quarter of 2024", PresentQuarter(tm, LocaleEnUS, DateLong|DateWithYear))
+	assert.Equal(t, "första kvartalet", PresentQuarter(time.Now().AddDate(0, -int(time.Now().Month()-1), 0), LocaleSvSE, DateLong))
+	assert.Equal(t, "the fourth quarter", PresentQuarter(time.Date(time.Now().Year(), time.December, 1, 0, 0, 0, 0, time.UTC), LocaleEnUS, DateLong))
+}
@@ -0,0 +1,351 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Countries with a holiday calendar
+const (
+	CountrySE = "SE"
+	CountryUS = "US"
+	CountryGB = "GB"
+)
+
+// HolidayKind tells if a holiday is a day off
+type HolidayKind int
+
+const (
+	// HolidayPublic is an official public holiday, "röd dag"
+	HolidayPublic HolidayKind = iota
+	// HolidayDayOff is not official but commonly a day off, such as julafton
+	HolidayDayOff
+	// HolidayHalfDay is commonly a shortened working day, such as trettondagsafton
+	HolidayHalfDay
+	// HolidayObservance is a normal working day, such as Halloween
+	HolidayObservance
+)
+
+// Holiday is a named day in a country calendar
+type Holiday struct {
+	// Key identifies the holiday across countries, such as "christmas_eve"
+	Key     string
+	Date    time.Time
+	Country string
+	Kind    HolidayKind
+	// Observed is set for a day off in place of a holiday on a weekend, such as
+	// the substitute day for Christmas Day on a Sunday
+	Observed bool
+}
+
+// Name returns the name of the holiday in locale, "julafton" or "Christmas Eve",
+// and "Christmas Day (observed)" for an observed day
+func (h Holiday) Name(locale string) string {
+	names := holidayNames[h.Key]
+	name, ok := names[locale]
+	if !ok {
+		name = names[LocaleEnUS]
+	}
+	switch {
+	case !h.Observed:
+		return name
+	case locale == LocaleSvSE:
+		return name + " (ersättningsdag)"
+	case locale == LocaleEnGB:
+		return name + " (substitute day)"
+	}
+	return name + " (observed)"
+}
+
+var (
+	holidayNames = map[string]map[string]string{
+		"new_years_day":          {LocaleSvSE: "nyårsdagen", LocaleEnUS: "New Year's Day"},
+		"twelfth_night":          {LocaleSvSE: "trettondagsafton", LocaleEnUS: "Twelfth Night"},
+		"epiphany":               {LocaleSvSE: "trettondedag jul", LocaleEnUS: "Epiphany"},
+		"maundy_thursday":        {LocaleSvSE: "skärtorsdagen", LocaleEnUS: "Maundy Thursday"},
+		"good_friday":            {LocaleSvSE: "långfredagen", LocaleEnUS: "Good Friday"},
+		"holy_saturday":          {LocaleSvSE: "påskafton", LocaleEnUS: "Holy Saturday"},
+		"easter_sunday":          {LocaleSvSE: "påskdagen", LocaleEnUS: "Easter Sunday"},
+		"easter_monday":          {LocaleSvSE: "annandag påsk", LocaleEnUS: "Easter Monday"},
+		"walpurgis_night":        {LocaleSvSE: "valborgsmässoafton", LocaleEnUS: "Walpurgis Night"},
+		"may_day":                {LocaleSvSE: "första maj", LocaleEnUS: "May Day"},
+		"ascension_day":          {LocaleSvSE: "Kristi himmelsfärdsdag", LocaleEnUS: "Ascension Day"},
+		"whitsun_eve":            {LocaleSvSE: "pingstafton", LocaleEnUS: "Whitsun Eve"},
+		"whit_sunday":            {LocaleSvSE: "pingstdagen", LocaleEnUS: "Whit Sunday"},
+		"national_day":           {LocaleSvSE: "Sveriges nationaldag", LocaleEnUS: "National Day of Sweden"},
+		"midsummer_eve":          {LocaleSvSE: "midsommarafton", LocaleEnUS: "Midsummer Eve"},
+		"midsummer_day":          {LocaleSvSE: "midsommardagen", LocaleEnUS: "Midsummer Day"},
+		"all_saints_eve":         {LocaleSvSE: "allhelgonaafton", LocaleEnUS: "All Saints' Eve"},
+		"all_saints_day":         {LocaleSvSE: "alla helgons dag", LocaleEnUS: "All Saints' Day"},
+		"christmas_eve":          {LocaleSvSE: "julafton", LocaleEnUS: "Christmas Eve"},
+		"christmas_day":          {LocaleSvSE: "juldagen", LocaleEnUS: "Christmas Day"},
+		"boxing_day":             {LocaleSvSE: "annandag jul", LocaleEnUS: "Boxing Day"},
+		"new_years_eve":          {LocaleSvSE: "nyårsafton", LocaleEnUS: "New Year's Eve"},
+		"martin_luther_king_day": {LocaleSvSE: "Martin Luther Kings dag", LocaleEnUS: "Martin Luther King Jr. Day"},
+		"presidents_day":         {LocaleSvSE: "presidentdagen", LocaleEnUS: "Presidents' Day"},
+		"memorial_day":           {LocaleEnUS: "Memorial Day"},
+		"juneteenth":             {LocaleEnUS: "Juneteenth"},
+		"independence_day":       {LocaleSvSE: "USA:s självständighetsdag", LocaleEnUS: "Independence Day"},
+		"labor_day":              {LocaleEnUS: "Labor Day"},
+		"columbus_day":           {LocaleEnUS: "Columbus Day"},
+		"veterans_day":           {LocaleEnUS: "Veterans Day"},
+		"thanksgiving":           {LocaleEnUS: "Thanksgiving"},
+		"halloween":              {LocaleEnUS: "Halloween"},
+		"early_may_bank_holiday": {LocaleEnUS: "Early May bank holiday"},
+		"spring_bank_holiday":    {LocaleEnUS: "Spring bank holiday"},
+		"summer_bank_holiday":    {LocaleEnUS: "Summer bank holiday"},
+	}
+
+	// other common names for holidays
+	holidayAliases = map[string]string{
+		// swe
+		"nyår":               "new_years_eve",
+		"trettondagen":       "epiphany",
+		"påsk":               "easter_sunday",
+		"valborg":            "walpurgis_night",
+		"kristi himmelsfärd": "ascension_day",
+		"pingst":             "whit_sunday",
+		"nationaldagen":      "national_day",
+		"midsommar":          "midsummer_eve",
+		"allhelgona":         "all_saints_day",
+		"jul":                "christmas_eve",
+		// eng
+		"new year's":         "new_years_day",
+		"new year":           "new_years_day",
+		"easter":             "easter_sunday",
+		"christmas":          "christmas_day",
+		"xmas":               "christmas_day",
+		"thanksgiving day":   "thanksgiving",
+		"fourth of july":     "independence_day",
+		"the fourth of july": "independence_day",
+	}
+
+	// "på julafton", "on thanksgiving", "julafton 2025"
+	holidayRegex = regexp.MustCompile(`^(?:på |on |at )?(.+?)(?:,? (\d{4}))?$`)
+)
+
+// Easter returns easter sunday in year, using the anonymous gregorian algorithm
+func Easter(year int, loc *time.Location) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+// nthWeekday returns the n:th weekday wd in month, n = -1 is the last one
+func nthWeekday(year int, month time.Month, wd time.Weekday, n int, loc *time.Location) time.Time {
+	if n < 0 {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, loc)
+		return addDay(last, -((int(last.Weekday()) - int(wd) + 7) % 7))
+	}
+	first := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	return addDay(first, (int(wd)-int(first.Weekday())+7)%7+(n-1)*7)
+}
+
+// weekdayFrom returns the first weekday wd on or after month/day
+func weekdayFrom(year int, month time.Month, day int, wd time.Weekday, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	return addDay(t, (int(wd)-int(t.Weekday())+7)%7)
+}
+
+// Holidays returns the holidays of country in year, sorted by date
+func Holidays(year int, country string) []Holiday {
+	return holidays(year, country, time.Local)
+}
+
+func holidays(year int, country string, loc *time.Location) []Holiday {
+	easter := Easter(year, loc)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	}
+
+	var list []Holiday
+	add := func(key string, t time.Time, kind HolidayKind) {
+		list = append(list, Holiday{Key: key, Date: t, Country: country, Kind: kind})
+	}
+	taken := func(t time.Time) bool {
+		for _, h := range list {
+			if h.Date.Equal(t) && h.Kind == HolidayPublic {
+				return true
+			}
+		}
+		return false
+	}
+	// the first free weekday on or after a bank holiday on a weekend is off
+	substitute := func(key string, t time.Time) {
+		if t.Weekday() != time.Saturday && t.Weekday() != time.Sunday {
+			return
+		}
+		for t.Weekday() == time.Saturday || t.Weekday() == time.Sunday || taken(t) {
+			t = addDay(t, 1)
+		}
+		list = append(list, Holiday{Key: key, Date: t, Country: country, Kind: HolidayPublic, Observed: true})
+	}
+	// a federal holiday on a saturday is off the friday before, on a sunday the monday after
+	observe := func(key string, t time.Time) {
+		switch t.Weekday() {
+		case time.Saturday:
+			t = addDay(t, -1)
+		case time.Sunday:
+			t = addDay(t, 1)
+		default:
+			return
+		}
+		if t.Year() == year {
+			list = append(list, Holiday{Key: key, Date: t, Country: country, Kind: HolidayPublic, Observed: true})
+		}
+	}
+
+	switch country {
+	case CountrySE:
+		// https://sv.wikipedia.org/wiki/Helgdagar_i_Sverige
+		add("new_years_day", date(time.January, 1), HolidayPublic)
+		add("twelfth_night", date(time.January, 5), HolidayHalfDay)
+		add("epiphany", date(time.January, 6), HolidayPublic)
+		add("maundy_thursday", addDay(easter, -3), HolidayHalfDay)
+		add("good_friday", addDay(easter, -2), HolidayPublic)
+		add("holy_saturday", addDay(easter, -1), HolidayDayOff)
+		add("easter_sunday", easter, HolidayPublic)
+		add("easter_monday", addDay(easter, 1), HolidayPublic)
+		add("walpurgis_night", date(time.April, 30), HolidayHalfDay)
+		add("may_day", date(time.May, 1), HolidayPublic)
+		add("ascension_day", addDay(easter, 39), HolidayPublic)
+		add("whitsun_eve", addDay(easter, 48), HolidayDayOff)
+		add("whit_sunday", addDay(easter, 49), HolidayPublic)
+		add("national_day", date(time.June, 6), HolidayPublic)
+		add("midsummer_eve", weekdayFrom(year, time.June, 19, time.Friday, loc), HolidayDayOff)
+		add("midsummer_day", weekdayFrom(year, time.June, 20, time.Saturday, loc), HolidayPublic)
+		add("all_saints_eve", weekdayFrom(year, time.October, 30, time.Friday, loc), HolidayHalfDay)
+		add("all_saints_day", weekdayFrom(year, time.October, 31, time.Saturday, loc), HolidayPublic)
+		add("christmas_eve", date(time.December, 24), HolidayDayOff)
+		add("christmas_day", date(time.December, 25), HolidayPublic)
+		add("boxing_day", date(time.December, 26), HolidayPublic)
+		add("new_years_eve", date(time.December, 31), HolidayDayOff)
+
+	case CountryUS:
+		// https://en.wikipedia.org/wiki/Federal_holidays_in_the_United_States
+		add("new_years_day", date(time.January, 1), HolidayPublic)
+		add("martin_luther_king_day", nthWeekday(year, time.January, time.Monday, 3, loc), HolidayPublic)
+		add("presidents_day", nthWeekday(year, time.February, time.Monday, 3, loc), HolidayPublic)
+		add("easter_sunday", easter, HolidayObservance)
+		add("memorial_day", nthWeekday(year, time.May, time.Monday, -1, loc), HolidayPublic)
+		if year >= 2021 {
+			add("juneteenth", date(time.June, 19), HolidayPublic)
+			observe("juneteenth", date(time.June, 19))
+		}
+		add("independence_day", date(time.July, 4), HolidayPublic)
+		observe("independence_day", date(time.July, 4))
+		add("labor_day", nthWeekday(year, time.September, time.Monday, 1, loc), HolidayPublic)
+		add("columbus_day", nthWeekday(year, time.October, time.Monday, 2, loc), HolidayPublic)
+		add("halloween", date(time.October, 31), HolidayObservance)
+		add("veterans_day", date(time.November, 11), HolidayPublic)
+		observe("veterans_day", date(time.November, 11))
+		add("thanksgiving", nthWeekday(year, time.November, time.Thursday, 4, loc), HolidayPublic)
+		add("christmas_eve", date(time.December, 24), HolidayObservance)
+		add("christmas_day", date(time.December, 25), HolidayPublic)
+		observe("christmas_day", date(time.December, 25))
+		add("new_years_eve", date(time.December, 31), HolidayObservance)
+		// new year's day on a saturday is observed on new year's eve
+		observe("new_years_day", date(time.January, 1))
+		observe("new_years_day", time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc))
+
+	case CountryGB:
+		// https://www.gov.uk/bank-holidays, England and Wales
+		add("new_years_day", date(time.January, 1), HolidayPublic)
+		add("good_friday", addDay(easter, -2), HolidayPublic)
+		add("easter_sunday", easter, HolidayObservance)
+		add("easter_monday", addDay(easter, 1), HolidayPublic)
+		add("early_may_bank_holiday", nthWeekday(year, time.May, time.Monday, 1, loc), HolidayPublic)
+		add("spring_bank_holiday", nthWeekday(year, time.May, time.Monday, -1, loc), HolidayPublic)
+		add("summer_bank_holiday", nthWeekday(year, time.August, time.Monday, -1, loc), HolidayPublic)
+		add("christmas_eve", date(time.December, 24), HolidayObservance)
+		add("christmas_day", date(time.December, 25), HolidayPublic)
+		add("boxing_day", date(time.December, 26), HolidayPublic)
+		add("new_years_eve", date(time.December, 31), HolidayObservance)
+		substitute("new_years_day", date(time.January, 1))
+		substitute("christmas_day", date(time.December, 25))
+		substitute("boxing_day", date(time.December, 26))
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Date.Before(list[j].Date)
+	})
+	return list
+}
+
+// localeCountry returns the country part of locale, "sv_SE" = "SE"
+func localeCountry(locale string) string {
+	if len(locale) == 5 {
+		return locale[3:]
+	}
+	return CountrySE
+}
+
+// holidayKey returns the key of the holiday named s in any locale
+func holidayKey(s string) (string, bool) {
+	s = strings.ToLower(s)
+	if key, ok := holidayAliases[s]; ok {
+		return key, true
+	}
+	for key, names := range holidayNames {
+		for _, name := range names {
+			if strings.ToLower(name) == s {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ParseHoliday parses a holiday relative to the current time, see Parser.ParseHoliday
+func ParseHoliday(s string) (Holiday, error) {
+	return NewParser(LocaleSvSE).ParseHoliday(s)
+}
+
+// ParseHoliday parses holiday names like "julafton", "på midsommarafton",
+// "annandag påsk 2025" or "on Thanksgiving". Without a year, the holiday is in
+// the year of the reference time. The calendar of the parser locale is
+// searched first, then the other known countries
+func (p *Parser) ParseHoliday(s string) (Holiday, error) {
+	match := holidayRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if match == nil {
+		return Holiday{}, fmt.Errorf("Cannot parse holiday: %s", s)
+	}
+	key, ok := holidayKey(match[1])
+	if !ok {
+		return Holiday{}, fmt.Errorf("Cannot parse holiday: %s", s)
+	}
+
+	now := p.now()
+	year := now.Year()
+	if match[2] != "" {
+		var err error
+		if year, err = ParseYear(match[2]); err != nil {
+			return Holiday{}, err
+		}
+	}
+
+	countries := []string{localeCountry(p.Locale), CountrySE, CountryGB, CountryUS}
+	for _, country := range countries {
+		for _, h := range holidays(year, country, now.Location()) {
+			if h.Key == key && !h.Observed {
+				return h, nil
+			}
+		}
+	}
+	return Holiday{}, fmt.Errorf("No %s in calendar: %s", key, s)
+}
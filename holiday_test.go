@@ -0,0 +1,168 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEaster(t *testing.T) {
+	expected := map[int]string{
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2038: "2038-04-25",
+	}
+	for year, expect := range expected {
+		assert.Equal(t, expect, Easter(year, time.UTC).Format("2006-01-02"))
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	expectedSE := map[string]string{
+		"new_years_day":   "2024-01-01",
+		"good_friday":     "2024-03-29",
+		"easter_monday":   "2024-04-01",
+		"ascension_day":   "2024-05-09",
+		"whit_sunday":     "2024-05-19",
+		"midsummer_eve":   "2024-06-21",
+		"midsummer_day":   "2024-06-22",
+		"all_saints_day":  "2024-11-02",
+		"christmas_eve":   "2024-12-24",
+		"twelfth_night":   "2024-01-05",
+		"all_saints_eve":  "2024-11-01",
+		"walpurgis_night": "2024-04-30",
+	}
+	found := map[string]string{}
+	for _, h := range Holidays(2024, CountrySE) {
+		found[h.Key] = h.Date.Format("2006-01-02")
+	}
+	for key, expect := range expectedSE {
+		assert.Equal(t, expect, found[key], key)
+	}
+
+	expectedUS := map[string]string{
+		"martin_luther_king_day": "2024-01-15",
+		"memorial_day":           "2024-05-27",
+		"labor_day":              "2024-09-02",
+		"thanksgiving":           "2024-11-28",
+	}
+	found = map[string]string{}
+	for _, h := range Holidays(2024, CountryUS) {
+		found[h.Key] = h.Date.Format("2006-01-02")
+	}
+	for key, expect := range expectedUS {
+		assert.Equal(t, expect, found[key], key)
+	}
+
+	expectedGB := map[string]string{
+		"early_may_bank_holiday": "2024-05-06",
+		"spring_bank_holiday":    "2024-05-27",
+		"summer_bank_holiday":    "2024-08-26",
+	}
+	found = map[string]string{}
+	for _, h := range Holidays(2024, CountryGB) {
+		found[h.Key] = h.Date.Format("2006-01-02")
+	}
+	for key, expect := range expectedGB {
+		assert.Equal(t, expect, found[key], key)
+	}
+
+	assert.Equal(t, 0, len(Holidays(2024, "XX")))
+
+	// midsommarafton is always the friday between june 19-25
+	for year := 2000; year < 2050; year++ {
+		for _, h := range Holidays(year, CountrySE) {
+			if h.Key == "midsummer_eve" {
+				assert.Equal(t, time.Friday, h.Date.Weekday())
+				assert.Equal(t, true, h.Date.Day() >= 19 && h.Date.Day() <= 25)
+			}
+		}
+	}
+}
+
+func TestHolidaysObserved(t *testing.T) {
+	observed := func(year int, country string) map[string]string {
+		found := map[string]string{}
+		for _, h := range Holidays(year, country) {
+			if h.Observed {
+				found[h.Key] = h.Date.Format("2006-01-02")
+			}
+		}
+		return found
+	}
+
+	// christmas day on a sunday, boxing day on the monday
+	assert.Equal(t, map[string]string{"new_years_day": "2022-01-03", "christmas_day": "2022-12-27"}, observed(2022, CountryGB))
+	// christmas day on a saturday, boxing day on the sunday
+	assert.Equal(t, map[string]string{"christmas_day": "2021-12-27", "boxing_day": "2021-12-28"}, observed(2021, CountryGB))
+
+	assert.Equal(t, map[string]string{"new_years_day": "2023-01-02", "veterans_day": "2023-11-10"}, observed(2023, CountryUS))
+	// new year's day 2022 on a saturday is observed on new year's eve
+	assert.Equal(t, map[string]string{"juneteenth": "2021-06-18", "independence_day": "2021-07-05", "christmas_day": "2021-12-24", "new_years_day": "2021-12-31"}, observed(2021, CountryUS))
+
+	cal := NewHolidayCalendar(CountryGB)
+	assert.False(t, cal.IsBusinessDay(time.Date(2022, time.December, 27, 9, 0, 0, 0, time.Local)))
+	assert.Equal(t, "2022-12-28", AddBusinessDays(time.Date(2022, time.December, 23, 9, 0, 0, 0, time.Local), 1, cal).Format("2006-01-02"))
+	cal = NewHolidayCalendar(CountryUS)
+	assert.False(t, cal.IsBusinessDay(time.Date(2021, time.December, 24, 9, 0, 0, 0, time.Local)))
+
+	// juneteenth is a federal holiday since 2021
+	for _, h := range Holidays(2019, CountryUS) {
+		assert.NotEqual(t, "juneteenth", h.Key)
+	}
+
+	h, err := testParser(LocaleEnGB).ParseHoliday("christmas 2022")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2022-12-25", h.Date.Format("2006-01-02"))
+	assert.Equal(t, "Christmas Day (substitute day)", Holiday{Key: "christmas_day", Observed: true}.Name(LocaleEnGB))
+	assert.Equal(t, "Christmas Day (observed)", Holiday{Key: "christmas_day", Observed: true}.Name(LocaleEnUS))
+}
+
+func TestHolidayName(t *testing.T) {
+	h := Holiday{Key: "christmas_eve"}
+	assert.Equal(t, "julafton", h.Name(LocaleSvSE))
+	assert.Equal(t, "Christmas Eve", h.Name(LocaleEnUS))
+	assert.Equal(t, "Christmas Eve", h.Name(LocaleEnGB))
+
+	h = Holiday{Key: "thanksgiving"}
+	assert.Equal(t, "Thanksgiving", h.Name(LocaleSvSE))
+}
+
+func TestParseHoliday(t *testing.T) {
+	expected := map[string]string{
+		// swe
+		"julafton":         "2024-12-24",
+		"på julafton":      "2024-12-24",
+		"annandag påsk":    "2024-04-01",
+		"midsommar":        "2024-06-21",
+		"Midsommarafton":   "2024-06-21",
+		"alla helgons dag": "2024-11-02",
+		"julafton 2025":    "2025-12-24",
+		"på valborg":       "2024-04-30",
+		// eng
+		"on Thanksgiving":    "2024-11-28",
+		"christmas":          "2024-12-25",
+		"Easter Monday 2025": "2025-04-21",
+		"boxing day":         "2024-12-26",
+	}
+	p := testParser(LocaleSvSE)
+	for s, expect := range expected {
+		h, err := p.ParseHoliday(s)
+		assert.Equal(t, nil, err, s)
+		assert.Equal(t, expect, h.Date.Format("2006-01-02"), s)
+	}
+
+	h, err := testParser(LocaleEnUS).ParseHoliday("easter")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, CountryUS, h.Country)
+
+	_, err = p.ParseHoliday("lillejulafton")
+	assert.NotEqual(t, nil, err)
+
+	tm, err := ParseTime("på julafton")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, time.December, tm.Month())
+	assert.Equal(t, 24, tm.Day())
+}
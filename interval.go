@@ -0,0 +1,37 @@
+package natural
+
+import (
+	"fmt"
+	"time"
+)
+
+// Interval is the half open time span [Start, End)
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains reports whether t is within the interval
+func (i Interval) Contains(t time.Time) bool {
+	return !t.Before(i.Start) && t.Before(i.End)
+}
+
+// Duration returns the length of the interval
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// formats as "2021-12-13 00:00 - 2021-12-20 00:00"
+func (i Interval) String() string {
+	return fmt.Sprintf("%s - %s", i.Start.Format("2006-01-02 15:04"), i.End.Format("2006-01-02 15:04"))
+}
+
+func dayInterval(t time.Time, days int) Interval {
+	start := beginningOfDay(t)
+	return Interval{Start: start, End: addDay(start, days)}
+}
+
+func monthInterval(year int, month time.Month, months int, loc *time.Location) Interval {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	return Interval{Start: start, End: start.AddDate(0, months, 0)}
+}
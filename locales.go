@@ -2,6 +2,13 @@ package natural
 
 import "time"
 
+// Locales understood by the presenters
+const (
+	LocaleSvSE = "sv_SE"
+	LocaleEnUS = "en_US"
+	LocaleEnGB = "en_GB"
+)
+
 // ...
 var (
 	tensSvSE = []string{
@@ -111,6 +118,7 @@ var (
 		"Tis":     time.Tuesday,
 		"Ons":     time.Wednesday,
 		"Tor":     time.Thursday,
+		"Tors":    time.Thursday,
 		"Fre":     time.Friday,
 		"Lör":     time.Saturday,
 	}
@@ -163,6 +171,16 @@ var (
 		time.Sunday:    "Söndag",
 	}
 
+	WeekdaysEnUS = map[time.Weekday]string{
+		time.Monday:    "Monday",
+		time.Tuesday:   "Tuesday",
+		time.Wednesday: "Wednesday",
+		time.Thursday:  "Thursday",
+		time.Friday:    "Friday",
+		time.Saturday:  "Saturday",
+		time.Sunday:    "Sunday",
+	}
+
 	MonthsSvSE = map[time.Month]string{
 		time.January:   "Januari",
 		time.February:  "Februari",
@@ -178,6 +196,56 @@ var (
 		time.December:  "December",
 	}
 
+	MonthsAbbrSvSE = map[time.Month]string{
+		time.January:   "jan",
+		time.February:  "feb",
+		time.March:     "mars",
+		time.April:     "apr",
+		time.May:       "maj",
+		time.June:      "juni",
+		time.July:      "juli",
+		time.August:    "aug",
+		time.September: "sep",
+		time.October:   "okt",
+		time.November:  "nov",
+		time.December:  "dec",
+	}
+
+	MonthsAbbrEnUS = map[time.Month]string{
+		time.January:   "Jan",
+		time.February:  "Feb",
+		time.March:     "Mar",
+		time.April:     "Apr",
+		time.May:       "May",
+		time.June:      "Jun",
+		time.July:      "Jul",
+		time.August:    "Aug",
+		time.September: "Sep",
+		time.October:   "Oct",
+		time.November:  "Nov",
+		time.December:  "Dec",
+	}
+
+	WeekdaysAbbrSvSE = map[time.Weekday]string{
+		time.Monday:    "mån",
+		time.Tuesday:   "tis",
+		time.Wednesday: "ons",
+		time.Thursday:  "tors",
+		time.Friday:    "fre",
+		time.Saturday:  "lör",
+		time.Sunday:    "sön",
+	}
+
+	WeekdaysAbbrEnUS = map[time.Weekday]string{
+		time.Monday:    "Mon",
+		time.Tuesday:   "Tue",
+		time.Wednesday: "Wed",
+		time.Thursday:  "Thu",
+		time.Friday:    "Fri",
+		time.Saturday:  "Sat",
+		time.Sunday:    "Sun",
+	}
+
 	fractionsSvSE = map[string]string{
 		"halv": "1/2", "hälften": "1/2",
 		"tredjedel":  "1/3",
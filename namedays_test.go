@@ -0,0 +1,57 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameDays(t *testing.T) {
+	assert.Equal(t, []string{"Karin", "Kajsa"}, NameDays(NewMonthDay("08-02")))
+	assert.Equal(t, []string{"Anna"}, NameDays(NewMonthDay("12-09")))
+	assert.Nil(t, NameDays(NewMonthDay("12-25")))
+}
+
+func TestNameDay(t *testing.T) {
+	expected := map[string]string{
+		"Anna":         "12-09",
+		"karin":        "08-02",
+		"Lars":         "08-10",
+		"Gustav Adolf": "11-06",
+		"Åsa":          "09-12",
+	}
+	for in, expect := range expected {
+		md, err := NameDay(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, md.String(), in)
+	}
+
+	_, err := NameDay("Kalle Anka")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestParseNameDay(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	expected := map[string]string{
+		"på Karins namnsdag":          "2024-08-02",
+		"Annas namnsdag":              "2024-12-09",
+		"Lars namnsdag":               "2024-08-10",
+		"när har Jonas namnsdag?":     "2024-03-29",
+		"Gustav Adolfs namnsdag 2025": "2025-11-06",
+	}
+	for in, expect := range expected {
+		res, err := p.ParseNameDay(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, res.Format("2006-01-02"), in)
+	}
+
+	_, err := p.ParseNameDay("på Kalles namnsdag")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestPresentNameDaysSvSE(t *testing.T) {
+	assert.Equal(t, "Karin och Kajsa", PresentNameDaysSvSE(time.Date(2024, 8, 2, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "Kasper, Melker och Baltsar", PresentNameDaysSvSE(time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "", PresentNameDaysSvSE(time.Date(2024, 12, 25, 12, 0, 0, 0, time.UTC)))
+}
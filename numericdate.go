@@ -0,0 +1,170 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateOrder is the order of the day, month and year fields in a numeric date
+type DateOrder int
+
+const (
+	// OrderDMY is "28/3/2024"
+	OrderDMY DateOrder = iota
+	// OrderMDY is "3/28/2024"
+	OrderMDY
+	// OrderYMD is "2024-03-28"
+	OrderYMD
+)
+
+func (o DateOrder) String() string {
+	switch o {
+	case OrderMDY:
+		return "MDY"
+	case OrderYMD:
+		return "YMD"
+	}
+	return "DMY"
+}
+
+var (
+	// DateOrders lists the numeric date orders used in a locale, most preferred first
+	DateOrders = map[string][]DateOrder{
+		LocaleSvSE: {OrderYMD, OrderDMY},
+		LocaleEnUS: {OrderMDY},
+		LocaleEnGB: {OrderDMY},
+	}
+
+	// "28/3", "3/28/2024", "28.3.24", "2024-03-28", "28/3 -24"
+	numericDateRegex = regexp.MustCompile(`^(\d{1,4})([-/.])(\d{1,2})(?:([-/.])(\d{1,4})| [-'](\d{2}))?$`)
+)
+
+// AmbiguousDateError is returned by ParseNumericDate, together with the
+// preferred reading, when the input is a valid date in more than one order
+type AmbiguousDateError struct {
+	Input    string
+	Readings []time.Time
+}
+
+func (e *AmbiguousDateError) Error() string {
+	dates := []string{}
+	for _, t := range e.Readings {
+		dates = append(dates, t.Format("2006-01-02"))
+	}
+	return fmt.Sprintf("ambiguous date '%s': %s", e.Input, strings.Join(dates, ", "))
+}
+
+// ParseNumericDate parses numeric dates such as "28/3", "3/28/2024", "28.3.24" or
+// "2024-03-28", using the day/month order preferred in locale. If more than one
+// order gives a valid date the preferred one is returned with an *AmbiguousDateError
+func ParseNumericDate(s string, locale string) (time.Time, error) {
+	return parseNumericDate(s, locale, true)
+}
+
+// parseNumericDate parses a numeric date, trying the orders of other locales
+// too if fallback is set
+func parseNumericDate(s string, locale string, fallback bool) (time.Time, error) {
+	match := numericDateRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return time.Time{}, fmt.Errorf("Cannot parse numeric date: %s", s)
+	}
+	a, sep, b, sep2, c, suffixYear := match[1], match[2], match[3], match[4], match[5], match[6]
+	if sep2 != "" && sep2 != sep {
+		return time.Time{}, fmt.Errorf("Mixed separators in date: %s", s)
+	}
+
+	// "2024-03-28"
+	if len(a) == 4 {
+		if c == "" {
+			return time.Time{}, fmt.Errorf("Cannot parse numeric date: %s", s)
+		}
+		return numericDate(a, b, c)
+	}
+
+	orders := []DateOrder{}
+	for _, o := range DateOrders[locale] {
+		if o != OrderYMD || (sep == "-" && c != "" && len(c) <= 2) {
+			orders = append(orders, o)
+		}
+	}
+	for _, o := range []DateOrder{OrderDMY, OrderMDY} {
+		if fallback && !hasDateOrder(orders, o) {
+			orders = append(orders, o)
+		}
+	}
+
+	year := suffixYear
+	if c != "" {
+		year = c
+	}
+
+	readings := []time.Time{}
+	for _, o := range orders {
+		var t time.Time
+		var err error
+		switch o {
+		case OrderDMY:
+			t, err = numericDate(year, b, a)
+		case OrderMDY:
+			t, err = numericDate(year, a, b)
+		case OrderYMD:
+			t, err = numericDate(a, b, c)
+		}
+		if err == nil && !hasDate(readings, t) {
+			readings = append(readings, t)
+		}
+	}
+
+	if len(readings) == 0 {
+		return time.Time{}, fmt.Errorf("Invalid date: %s", s)
+	}
+	if len(readings) > 1 {
+		return readings[0], &AmbiguousDateError{Input: s, Readings: readings}
+	}
+	return readings[0], nil
+}
+
+// numericDate validates and returns the date, an empty year means the current year
+func numericDate(year, month, day string) (time.Time, error) {
+	y := time.Now().Year()
+	if year != "" {
+		var err error
+		if y, err = ParseYear(year); err != nil {
+			return time.Time{}, err
+		}
+	}
+	m, err := strconv.Atoi(month)
+	if err != nil {
+		return time.Time{}, err
+	}
+	d, err := strconv.Atoi(day)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.Local)
+	if m < 1 || m > 12 || t.Day() != d {
+		return t, fmt.Errorf("Invalid date: %d-%02d-%02d", y, m, d)
+	}
+	return t, nil
+}
+
+func hasDateOrder(orders []DateOrder, o DateOrder) bool {
+	for _, x := range orders {
+		if x == o {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDate(list []time.Time, t time.Time) bool {
+	for _, x := range list {
+		if x.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNumericDate(t *testing.T) {
+	year := time.Now().Year()
+	expected := map[string]map[string]time.Time{
+		LocaleSvSE: {
+			"28/3":       time.Date(year, time.March, 28, 0, 0, 0, 0, time.Local),
+			"28.3.24":    time.Date(2024, time.March, 28, 0, 0, 0, 0, time.Local),
+			"2024-03-28": time.Date(2024, time.March, 28, 0, 0, 0, 0, time.Local),
+			"28/3 -24":   time.Date(2024, time.March, 28, 0, 0, 0, 0, time.Local),
+			"28/3/2024":  time.Date(2024, time.March, 28, 0, 0, 0, 0, time.Local),
+		},
+		LocaleEnUS: {
+			"3/28/2024":  time.Date(2024, time.March, 28, 0, 0, 0, 0, time.Local),
+			"3/28":       time.Date(year, time.March, 28, 0, 0, 0, 0, time.Local),
+			"2024-03-28": time.Date(2024, time.March, 28, 0, 0, 0, 0, time.Local),
+		},
+		LocaleEnGB: {
+			"28/3/2024": time.Date(2024, time.March, 28, 0, 0, 0, 0, time.Local),
+			"28/03/24":  time.Date(2024, time.March, 28, 0, 0, 0, 0, time.Local),
+		},
+	}
+	for locale, inputs := range expected {
+		for s, expect := range inputs {
+			tm, err := ParseNumericDate(s, locale)
+			assert.Equal(t, nil, err, locale+": "+s)
+			assert.Equal(t, expect, tm, locale+": "+s)
+		}
+	}
+
+	// impossible dates
+	for _, s := range []string{"30/2/2024", "13/13", "2023-02-29", "28/3.2024", "2024-03"} {
+		_, err := ParseNumericDate(s, LocaleSvSE)
+		assert.NotEqual(t, nil, err, s)
+	}
+}
+
+func TestParseNumericDateAmbiguous(t *testing.T) {
+	tm, err := ParseNumericDate("3/4/2024", LocaleEnUS)
+	assert.Equal(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local), tm)
+	amb, ok := err.(*AmbiguousDateError)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 2, len(amb.Readings))
+	assert.Equal(t, "ambiguous date '3/4/2024': 2024-03-04, 2024-04-03", err.Error())
+
+	tm, err = ParseNumericDate("3/4/2024", LocaleEnGB)
+	assert.Equal(t, time.Date(2024, time.April, 3, 0, 0, 0, 0, time.Local), tm)
+	_, ok = err.(*AmbiguousDateError)
+	assert.Equal(t, true, ok)
+
+	tm, err = ParseNumericDate("24-03-28", LocaleSvSE)
+	assert.Equal(t, time.Date(2024, time.March, 28, 0, 0, 0, 0, time.Local), tm)
+	_, ok = err.(*AmbiguousDateError)
+	assert.Equal(t, true, ok)
+
+	// same date in both orders
+	_, err = ParseNumericDate("4/4/2024", LocaleEnGB)
+	assert.Equal(t, nil, err)
+}
+
+func TestParseTimeNumericDate(t *testing.T) {
+	tm, err := ParseTime("28/3 -24")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-03-28", tm.Format("2006-01-02"))
+
+	tm, err = ParseTime("2024-03-28")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-03-28", tm.Format("2006-01-02"))
+}
+
+func TestParseTimeNumericDateLocale(t *testing.T) {
+	// the test parser is at 2024-04-17 15:30
+	p := testParser(LocaleEnUS)
+	tm, err := p.ParseTime("3/28")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-03-28 00:00", tm.Format("2006-01-02 15:04"))
+
+	// day first is not a us order
+	_, err = p.ParseTime("28/3")
+	assert.NotEqual(t, nil, err)
+
+	// hours and minutes are clock times, not dates
+	p = testParser(LocaleSvSE)
+	for in, exp := range map[string]string{
+		"9.15":     "2024-04-17 09:15",
+		"kl 18.30": "2024-04-17 18:30",
+		"28.3":     "2024-03-28 00:00",
+	} {
+		tm, err = p.ParseTime(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, tm.Format("2006-01-02 15:04"), in)
+	}
+
+	_, err = p.ParseTime("22-02")
+	assert.NotEqual(t, nil, err)
+}
@@ -0,0 +1,377 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ClockRange is a time of day range in minutes after midnight. End is past
+// 24*60 for ranges ending after midnight
+type ClockRange struct {
+	Start int
+	End   int
+}
+
+// OpeningHours is a weekly schedule with exceptions for holidays
+type OpeningHours struct {
+	// Weekly holds the hours of each weekday, indexed by time.Weekday. Closed days are empty
+	Weekly [7][]ClockRange
+	// Holidays overrides the weekly hours on holidays, by holiday key. The key "*"
+	// applies to all public holidays. An empty list means closed
+	Holidays map[string][]ClockRange
+	// Country decides the holidays, see Holidays
+	Country string
+}
+
+var (
+	// "9-17", "09:30–17", "9am-5pm", "10.00 - 14.00"
+	clockRangeRegex = regexp.MustCompile(`(\d{1,2})(?:[:.](\d{2}))?\s*(am|pm)?\s*[-–—]\s*(\d{1,2})(?:[:.](\d{2}))?\s*(am|pm)?`)
+
+	// "stängt", "dygnet runt", "closed", "open 24 hours"
+	openingKeywordRegex = regexp.MustCompile(`(?:stängt|stängd|closed|(?:öppet )?dygnet runt|(?:open )?24 hours|24h|24/7)$`)
+
+	openingDays = map[string][]time.Weekday{
+		// swe
+		"vardagar": workWeek, "vardag": workWeek,
+		"helger": {time.Saturday, time.Sunday}, "helg": {time.Saturday, time.Sunday},
+		"alla dagar": allWeek, "dagligen": allWeek,
+		// eng
+		"weekdays": workWeek,
+		"weekends": {time.Saturday, time.Sunday}, "weekend": {time.Saturday, time.Sunday},
+		"daily": allWeek, "every day": allWeek,
+	}
+
+	openingAllHolidays = []string{"helgdagar", "helgdag", "röda dagar", "public holidays", "holidays", "bank holidays"}
+
+	allWeek = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}
+)
+
+// ParseOpeningHours parses opening hours with Swedish holidays, see Parser.ParseOpeningHours
+func ParseOpeningHours(s string) (OpeningHours, error) {
+	return NewParser(LocaleSvSE).ParseOpeningHours(s)
+}
+
+// ParseOpeningHours parses opening hours like "mån-fre 09-17, lör 10-14, sön stängt",
+// "vardagar 8–16, helgdagar stängt" or "Mon–Fri 9am–5pm, Sat 10am-2pm". Parts are
+// separated by comma, semicolon or newline, a part without days adds hours to the
+// previous days, as in "mån-fre 9-12, 13-17". Holidays are those of the parser locale
+func (p *Parser) ParseOpeningHours(s string) (OpeningHours, error) {
+	o := OpeningHours{Holidays: map[string][]ClockRange{}, Country: localeCountry(p.Locale)}
+	segments := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return r == ',' || r == ';' || r == '\n'
+	})
+
+	var pendingDays []time.Weekday
+	var pendingHolidays []string
+	var lastDays []time.Weekday
+	var lastHolidays []string
+	for _, seg := range segments {
+		seg = strings.TrimSpace(seg)
+		if seg == "" {
+			continue
+		}
+		daysPart, ranges, hasHours, err := splitOpeningSegment(seg)
+		if err != nil {
+			return o, err
+		}
+
+		days, holidays := pendingDays, pendingHolidays
+		if daysPart != "" {
+			d, h, err := parseOpeningDays(daysPart)
+			if err != nil {
+				return o, err
+			}
+			days, holidays = append(days, d...), append(holidays, h...)
+		}
+		if !hasHours {
+			// "mån, ons 9-17"
+			pendingDays, pendingHolidays = days, holidays
+			continue
+		}
+		pendingDays, pendingHolidays = nil, nil
+
+		if len(days) == 0 && len(holidays) == 0 {
+			if lastDays == nil && lastHolidays == nil {
+				lastDays = allWeek
+			}
+			// "mån-fre 9-12, 13-17"
+			for _, wd := range lastDays {
+				o.Weekly[wd] = sortClockRanges(append(o.Weekly[wd], ranges...))
+			}
+			for _, key := range lastHolidays {
+				o.Holidays[key] = sortClockRanges(append(o.Holidays[key], ranges...))
+			}
+			continue
+		}
+
+		for _, wd := range days {
+			o.Weekly[wd] = sortClockRanges(append([]ClockRange{}, ranges...))
+		}
+		for _, key := range holidays {
+			o.Holidays[key] = sortClockRanges(append([]ClockRange{}, ranges...))
+		}
+		lastDays, lastHolidays = days, holidays
+	}
+	if pendingDays != nil || pendingHolidays != nil {
+		return o, fmt.Errorf("Missing opening hours: %s", s)
+	}
+	return o, nil
+}
+
+// splitOpeningSegment splits "mån-fre 9-17" into days and hours
+func splitOpeningSegment(seg string) (string, []ClockRange, bool, error) {
+	var ranges []ClockRange
+	idx := -1
+	if loc := openingKeywordRegex.FindStringIndex(seg); loc != nil {
+		idx = loc[0]
+		switch seg[loc[0]:] {
+		case "stängt", "stängd", "closed":
+		default:
+			ranges = []ClockRange{{Start: 0, End: 24 * 60}}
+		}
+	} else if matches := clockRangeRegex.FindAllStringSubmatchIndex(seg, -1); matches != nil {
+		idx = matches[0][0]
+		for _, m := range matches {
+			r, err := parseClockRange(seg, m)
+			if err != nil {
+				return "", nil, false, err
+			}
+			ranges = append(ranges, r)
+		}
+	}
+	if idx == -1 {
+		return strings.TrimSpace(seg), nil, false, nil
+	}
+
+	days := strings.TrimSpace(seg[:idx])
+	for _, suffix := range []string{":", " kl", " klockan", " öppet", " open"} {
+		days = strings.TrimSpace(strings.TrimSuffix(days, suffix))
+	}
+	return days, ranges, true, nil
+}
+
+// parseClockRange parses a match of clockRangeRegex in s
+func parseClockRange(s string, m []int) (ClockRange, error) {
+	group := func(i int) string {
+		if m[2*i] == -1 {
+			return ""
+		}
+		return s[m[2*i]:m[2*i+1]]
+	}
+	clock := func(h, min, period string) (int, error) {
+		hour, minute := 0, 0
+		fmt.Sscanf(h, "%d", &hour)
+		if min != "" {
+			fmt.Sscanf(min, "%d", &minute)
+		}
+		if hour > 24 || minute > 59 || (period != "" && (hour == 0 || hour > 12)) {
+			return 0, fmt.Errorf("Invalid time of day: %s", s)
+		}
+		switch period {
+		case "am":
+			hour = hour % 12
+		case "pm":
+			hour = hour%12 + 12
+		}
+		return hour*60 + minute, nil
+	}
+
+	start, err := clock(group(1), group(2), group(3))
+	if err != nil {
+		return ClockRange{}, err
+	}
+	end, err := clock(group(4), group(5), group(6))
+	if err != nil {
+		return ClockRange{}, err
+	}
+	// "1-5pm"
+	if group(3) == "" && group(6) == "pm" && start < 12*60 && start+12*60 < end {
+		start += 12 * 60
+	}
+	// "18-00", "22-02"
+	if end <= start {
+		end += 24 * 60
+	}
+	return ClockRange{Start: start, End: end}, nil
+}
+
+// parseOpeningDays parses "mån-fre", "vardagar", "helgdagar" or "julafton"
+// into weekdays and holiday keys
+func parseOpeningDays(s string) ([]time.Weekday, []string, error) {
+	if days, ok := openingDays[s]; ok {
+		return days, nil, nil
+	}
+	for _, name := range openingAllHolidays {
+		if s == name {
+			return nil, []string{"*"}, nil
+		}
+	}
+	if key, ok := holidayKey(s); ok {
+		return nil, []string{key}, nil
+	}
+	days, err := parseWeekdayList(s)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Cannot parse opening days: %s", s)
+	}
+	return days, nil, nil
+}
+
+func sortClockRanges(ranges []ClockRange) []ClockRange {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Start < ranges[j].Start })
+	return ranges
+}
+
+// hoursOn returns the opening hours of the day of t
+func (o OpeningHours) hoursOn(t time.Time) []ClockRange {
+	if len(o.Holidays) > 0 {
+		country := o.Country
+		if country == "" {
+			country = CountrySE
+		}
+		day := beginningOfDay(t)
+		for _, h := range holidays(t.Year(), country, t.Location()) {
+			if !h.Date.Equal(day) {
+				continue
+			}
+			if ranges, ok := o.Holidays[h.Key]; ok {
+				return ranges
+			}
+			if ranges, ok := o.Holidays["*"]; ok && h.Kind == HolidayPublic {
+				return ranges
+			}
+		}
+	}
+	return o.Weekly[t.Weekday()]
+}
+
+// IsOpen returns true if o is open at t
+func (o OpeningHours) IsOpen(t time.Time) bool {
+	m := t.Hour()*60 + t.Minute()
+	for _, r := range o.hoursOn(t) {
+		if m >= r.Start && m < r.End {
+			return true
+		}
+	}
+	// open past midnight the day before
+	for _, r := range o.hoursOn(addDay(t, -1)) {
+		if m+24*60 < r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen returns t if o is open at t, otherwise the next time it opens.
+// It returns false if o does not open within a year
+func (o OpeningHours) NextOpen(t time.Time) (time.Time, bool) {
+	if o.IsOpen(t) {
+		return t, true
+	}
+	for i := 0; i <= 366; i++ {
+		day := addDay(t, i)
+		for _, r := range o.hoursOn(day) {
+			start := time.Date(day.Year(), day.Month(), day.Day(), r.Start/60, r.Start%60, 0, 0, t.Location())
+			if start.After(t) {
+				return start, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// PresentOpeningHours returns o as compact text, with days that share hours
+// collapsed into ranges, such as "mån–fre 9–17, lör 10–14, sön stängt" or
+// "Mon–Fri 9 AM–5 PM, Sat 10 AM–2 PM, Sun closed"
+func PresentOpeningHours(o OpeningHours, locale string) string {
+	parts := []string{}
+	for i := 0; i < len(allWeek); {
+		j := i
+		for j+1 < len(allWeek) && equalClockRanges(o.Weekly[allWeek[i]], o.Weekly[allWeek[j+1]]) {
+			j++
+		}
+		days := weekdayName(allWeek[i], locale, true, false)
+		switch {
+		case i == 0 && j == len(allWeek)-1 && locale == LocaleSvSE:
+			days = "alla dagar"
+		case i == 0 && j == len(allWeek)-1:
+			days = "daily"
+		case j > i:
+			days += "–" + weekdayName(allWeek[j], locale, true, false)
+		}
+		parts = append(parts, days+" "+presentClockRanges(o.Weekly[allWeek[i]], locale))
+		i = j + 1
+	}
+
+	keys := []string{}
+	for key := range o.Holidays {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		name := Holiday{Key: key}.Name(locale)
+		if key == "*" {
+			name = "public holidays"
+			if locale == LocaleSvSE {
+				name = "helgdagar"
+			}
+		}
+		parts = append(parts, name+" "+presentClockRanges(o.Holidays[key], locale))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// presentClockRanges returns "9–12 och 13–17", "dygnet runt" or "stängt"
+func presentClockRanges(ranges []ClockRange, locale string) string {
+	sv := locale == LocaleSvSE
+	if len(ranges) == 0 {
+		if sv {
+			return "stängt"
+		}
+		return "closed"
+	}
+	if len(ranges) == 1 && ranges[0].Start == 0 && ranges[0].End == 24*60 {
+		if sv {
+			return "dygnet runt"
+		}
+		return "24 hours"
+	}
+
+	list := []string{}
+	for _, r := range ranges {
+		if sv {
+			list = append(list, clockSvSEDigits(r.Start)+"–"+clockSvSEDigits(r.End))
+		} else {
+			list = append(list, clockEnUSDigits(r.Start)+"–"+clockEnUSDigits(r.End%(24*60)))
+		}
+	}
+	if sv {
+		return PresentListSvSE(list)
+	}
+	return PresentListEnUS(list)
+}
+
+// clockSvSEDigits returns minutes after midnight as "9", "9:30" or "24"
+func clockSvSEDigits(c int) string {
+	if c > 24*60 {
+		c -= 24 * 60
+	}
+	if c%60 == 0 {
+		return fmt.Sprintf("%d", c/60)
+	}
+	return fmt.Sprintf("%d:%02d", c/60, c%60)
+}
+
+func equalClockRanges(a, b []ClockRange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOpeningHours(t *testing.T) {
+	expected := map[string]string{
+		"mån-fre 09-17, lör 10-14":            "mån–fre 9–17, lör 10–14, sön stängt",
+		"mån–fre 9–17, lör 10–14, sön stängt": "mån–fre 9–17, lör 10–14, sön stängt",
+		"vardagar 8–16":                       "mån–fre 8–16, lör–sön stängt",
+		"mån-fre 9-12, 13-17":                 "mån–fre 9–12 och 13–17, lör–sön stängt",
+		"mån, ons 9:30-18":                    "mån 9:30–18, tis stängt, ons 9:30–18, tors–sön stängt",
+		"dagligen 10.00-22.00":                "alla dagar 10–22",
+		"alla dagar dygnet runt":              "alla dagar dygnet runt",
+		"fre-lör 18-02":                       "mån–tors stängt, fre–lör 18–2, sön stängt",
+		"vardagar 8-16; helgdagar stängt":     "mån–fre 8–16, lör–sön stängt, helgdagar stängt",
+		"vardagar 8-16\njulafton 10-13":       "mån–fre 8–16, lör–sön stängt, julafton 10–13",
+		"Mon–Fri 9am–5pm, Sat 10am-2pm":       "mån–fre 9–17, lör 10–14, sön stängt",
+		"weekdays 9-5pm, weekends closed":     "mån–fre 9–17, lör–sön stängt",
+		"mån-tors 11-1pm":                     "mån–tors 11–13, fre–sön stängt",
+		"Monday-Friday: 8:30 am - 4:30 pm":    "mån–fre 8:30–16:30, lör–sön stängt",
+	}
+	for in, expect := range expected {
+		o, err := ParseOpeningHours(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, PresentOpeningHours(o, LocaleSvSE), in)
+	}
+
+	for _, in := range []string{"ibland", "mån-fre", "mån-fre 25-26", "blåsdag 9-17"} {
+		_, err := ParseOpeningHours(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
+
+func TestPresentOpeningHoursEnUS(t *testing.T) {
+	expected := map[string]string{
+		"mån-fre 9-17, lör 10-14":        "Mon–Fri 9 AM–5 PM, Sat 10 AM–2 PM, Sun closed",
+		"daily 24 hours":                 "daily 24 hours",
+		"fri 18-00":                      "Mon–Thu closed, Fri 6 PM–12 AM, Sat–Sun closed",
+		"weekdays 9-17, holidays closed": "Mon–Fri 9 AM–5 PM, Sat–Sun closed, public holidays closed",
+	}
+	for in, expect := range expected {
+		o, err := ParseOpeningHours(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, PresentOpeningHours(o, LocaleEnUS), in)
+	}
+}
+
+func TestOpeningHoursIsOpen(t *testing.T) {
+	o, err := ParseOpeningHours("mån-fre 9-17, lör 10-14, fre 18-02, helgdagar stängt")
+	assert.Equal(t, nil, err)
+
+	expected := map[string]bool{
+		"2024-04-17 08:59": false,
+		"2024-04-17 09:00": true,
+		"2024-04-17 16:59": true,
+		"2024-04-17 17:00": false,
+		"2024-04-19 10:00": false, // "fre 18-02" replaces friday
+		"2024-04-19 23:00": true,
+		"2024-04-20 01:30": true, // past midnight
+		"2024-04-20 02:00": false,
+		"2024-04-20 11:00": true,
+		"2024-04-21 12:00": false,
+		"2024-05-01 12:00": false, // första maj
+	}
+	for in, expect := range expected {
+		ts, _ := time.Parse("2006-01-02 15:04", in)
+		assert.Equal(t, expect, o.IsOpen(ts), in)
+	}
+}
+
+func TestOpeningHoursNextOpen(t *testing.T) {
+	o, err := ParseOpeningHours("vardagar 9-17, helgdagar stängt")
+	assert.Equal(t, nil, err)
+
+	expected := map[string]string{
+		"2024-04-17 08:00": "2024-04-17 09:00",
+		"2024-04-17 12:00": "2024-04-17 12:00",
+		"2024-04-17 18:00": "2024-04-18 09:00",
+		"2024-04-19 18:00": "2024-04-22 09:00",
+		"2024-04-30 18:00": "2024-05-02 09:00", // första maj
+		"2024-12-23 18:00": "2024-12-24 09:00",
+		"2024-12-24 18:00": "2024-12-27 09:00",
+	}
+	for in, expect := range expected {
+		ts, _ := time.Parse("2006-01-02 15:04", in)
+		next, ok := o.NextOpen(ts)
+		assert.True(t, ok, in)
+		assert.Equal(t, expect, next.Format("2006-01-02 15:04"), in)
+	}
+
+	closed, _ := ParseOpeningHours("alla dagar stängt")
+	_, ok := closed.NextOpen(time.Now())
+	assert.False(t, ok)
+}
@@ -0,0 +1,107 @@
+package natural
+
+import "time"
+
+// Preference decides which occurrence incomplete dates and times, such as
+// "fredag", "15 mars", "mars" or "kl 8", resolve to
+type Preference int
+
+const (
+	// PreferCurrent fills in the missing parts from the reference time, "15 mars"
+	// is March 15th this year and "fredag" is friday this week
+	PreferCurrent Preference = iota
+	// PreferFuture picks the next occurrence, or the current one if it has not passed
+	PreferFuture
+	// PreferPast picks the most recent occurrence, or the current one if it has begun
+	PreferPast
+	// PreferNearest picks the occurrence closest to the reference time
+	PreferNearest
+)
+
+// Parser resolves relative expressions, such as "nästa vecka", against a
+// reference time and a locale
+type Parser struct {
+	// Locale decides week start and numeric date order
+	Locale string
+	// Now returns the reference time
+	Now func() time.Time
+	// Calendar decides business days, defaults to the holidays of the locale country
+	Calendar Calendar
+	// Location is the default time zone of parsed times, defaults to the zone of Now
+	Location *time.Location
+	// Prefer decides how weekdays, month days, months and clock times are resolved
+	Prefer Preference
+	// DayParts overrides the hours of day parts, see DefaultDayParts
+	DayParts map[DayPart]ClockRange
+	// FiscalYearStart is the first month of the fiscal year, quarters, halves and
+	// tertials are counted from it. Defaults to January
+	FiscalYearStart time.Month
+	// Anchors names times by lowercase name, such as "deadline", for use in
+	// expressions like "tre dagar innan deadline"
+	Anchors map[string]time.Time
+}
+
+// NewParser returns a Parser for locale, relative to the current time
+func NewParser(locale string) *Parser {
+	return &Parser{
+		Locale: locale,
+		Now:    time.Now,
+	}
+}
+
+func (p *Parser) now() time.Time {
+	t := time.Now()
+	if p.Now != nil {
+		t = p.Now()
+	}
+	if p.Location != nil {
+		return t.In(p.Location)
+	}
+	return t
+}
+
+// prefer moves i, filled in from now, by whole steps of years and days to the
+// occurrence selected by the parser preference
+func (p *Parser) prefer(i Interval, now time.Time, years, days int) Interval {
+	shift := func(n int) Interval {
+		return Interval{Start: i.Start.AddDate(years*n, 0, days*n), End: i.End.AddDate(years*n, 0, days*n)}
+	}
+	switch p.Prefer {
+	case PreferFuture:
+		if distance(i, now) < 0 {
+			return shift(1)
+		}
+	case PreferPast:
+		if distance(i, now) > 0 {
+			return shift(-1)
+		}
+	case PreferNearest:
+		best := i
+		for _, c := range []Interval{shift(-1), shift(1)} {
+			if absDuration(distance(c, now)) < absDuration(distance(best, now)) {
+				best = c
+			}
+		}
+		return best
+	}
+	return i
+}
+
+// distance returns how far ahead of t the interval begins, or how long ago it
+// ended as a negative duration. Intervals containing t are 0 away
+func distance(i Interval, t time.Time) time.Duration {
+	switch {
+	case i.Start.After(t):
+		return i.Start.Sub(t)
+	case i.End.After(t) || i.Start.Equal(t):
+		return 0
+	}
+	return i.End.Sub(t)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
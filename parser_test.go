@@ -0,0 +1,107 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParserPrefer(t *testing.T) {
+	// the test parser is at wednesday 2024-04-17 15:30
+	expected := map[Preference]map[string]string{
+		PreferCurrent: {
+			"fredag":       "2024-04-19 00:00",
+			"måndag":       "2024-04-15 00:00",
+			"15 mars":      "2024-03-15 00:00",
+			"20 december":  "2024-12-20 00:00",
+			"28/3":         "2024-03-28 00:00",
+			"kl 8":         "2024-04-17 08:00",
+			"kl 16":        "2024-04-17 16:00",
+			"15 mars kl 9": "2024-03-15 09:00",
+		},
+		PreferFuture: {
+			"fredag":       "2024-04-19 00:00",
+			"måndag":       "2024-04-22 00:00",
+			"onsdag":       "2024-04-17 00:00",
+			"15 mars":      "2025-03-15 00:00",
+			"17 april":     "2024-04-17 00:00",
+			"march 15":     "2025-03-15 00:00",
+			"28/3":         "2025-03-28 00:00",
+			"kl 8":         "2024-04-18 08:00",
+			"kl 16":        "2024-04-17 16:00",
+			"15:30":        "2024-04-17 15:30",
+			"halv fyra":    "2024-04-18 03:30",
+			"15 mars kl 9": "2025-03-15 09:00",
+			"15 mars 2024": "2024-03-15 00:00",
+		},
+		PreferPast: {
+			"on friday":      "2024-04-12 00:00",
+			"måndag":         "2024-04-15 00:00",
+			"onsdag":         "2024-04-17 00:00",
+			"20 december":    "2023-12-20 00:00",
+			"17 april":       "2024-04-17 00:00",
+			"kl 8":           "2024-04-17 08:00",
+			"kl 16":          "2024-04-16 16:00",
+			"sex på kvällen": "2024-04-16 18:00",
+		},
+		PreferNearest: {
+			"fredag":      "2024-04-19 00:00",
+			"måndag":      "2024-04-15 00:00",
+			"15 mars":     "2024-03-15 00:00",
+			"20 december": "2023-12-20 00:00",
+			"kl 16":       "2024-04-17 16:00",
+		},
+	}
+	for prefer, cases := range expected {
+		p := testParser(LocaleSvSE)
+		p.Prefer = prefer
+		for in, exp := range cases {
+			res, err := p.ParseTime(in)
+			assert.Equal(t, nil, err, in)
+			assert.Equal(t, exp, res.Format("2006-01-02 15:04"), in)
+		}
+	}
+}
+
+func TestParserPreferMonth(t *testing.T) {
+	expected := map[Preference]map[string]string{
+		PreferCurrent: {
+			"mars":     "2024-03-01 00:00 - 2024-04-01 00:00",
+			"december": "2024-12-01 00:00 - 2025-01-01 00:00",
+		},
+		PreferFuture: {
+			"mars":  "2025-03-01 00:00 - 2025-04-01 00:00",
+			"april": "2024-04-01 00:00 - 2024-05-01 00:00",
+			"maj":   "2024-05-01 00:00 - 2024-06-01 00:00",
+		},
+		PreferPast: {
+			"mars":  "2024-03-01 00:00 - 2024-04-01 00:00",
+			"april": "2024-04-01 00:00 - 2024-05-01 00:00",
+			"maj":   "2023-05-01 00:00 - 2023-06-01 00:00",
+		},
+		PreferNearest: {
+			"maj":        "2024-05-01 00:00 - 2024-06-01 00:00",
+			"december":   "2023-12-01 00:00 - 2024-01-01 00:00",
+			"mars 2025":  "2025-03-01 00:00 - 2025-04-01 00:00",
+			"early june": "2024-06-01 00:00 - 2024-06-11 00:00",
+		},
+	}
+	for prefer, cases := range expected {
+		p := testParser(LocaleSvSE)
+		p.Prefer = prefer
+		for in, exp := range cases {
+			res, err := p.ParsePeriod(in)
+			assert.Equal(t, nil, err, in)
+			assert.Equal(t, exp, res.String(), in)
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	now := time.Date(2024, time.April, 17, 15, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Duration(0), distance(dayInterval(now, 1), now))
+	assert.Equal(t, 8*time.Hour+30*time.Minute, distance(dayInterval(addDay(now, 1), 1), now))
+	assert.Equal(t, -15*time.Hour-30*time.Minute, distance(dayInterval(addDay(now, -1), 1), now))
+	assert.Equal(t, time.Duration(0), distance(Interval{Start: now, End: now}, now))
+}
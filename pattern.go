@@ -0,0 +1,269 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// patternToken is a run of one pattern letter, or a literal
+type patternToken struct {
+	letter  rune
+	count   int
+	literal string
+}
+
+// tokenizePattern splits a CLDR style pattern such as "EEEE d MMMM y" into tokens.
+// Text in single quotes is literal, two single quotes is a quote
+func tokenizePattern(pattern string) []patternToken {
+	var tokens []patternToken
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'':
+			lit := ""
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						lit += "'"
+						i++
+						continue
+					}
+					break
+				}
+				lit += string(runes[i])
+			}
+			if lit == "" {
+				lit = "'"
+			}
+			tokens = append(tokens, patternToken{literal: lit})
+		case (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+			n := 1
+			for i+1 < len(runes) && runes[i+1] == r {
+				n++
+				i++
+			}
+			tokens = append(tokens, patternToken{letter: r, count: n})
+		default:
+			tokens = append(tokens, patternToken{literal: string(r)})
+		}
+	}
+	return tokens
+}
+
+// monthName returns the name of m in locale. Standalone forms are used
+// on their own, such as in headings, and are capitalized in Swedish
+func monthName(m time.Month, locale string, abbr, standalone bool) string {
+	switch locale {
+	case LocaleSvSE:
+		s := strings.ToLower(MonthsSvSE[m])
+		if abbr {
+			s = MonthsAbbrSvSE[m]
+		}
+		if standalone {
+			s = ucFirst(s)
+		}
+		return s
+	}
+	if abbr {
+		return MonthsAbbrEnUS[m]
+	}
+	return m.String()
+}
+
+// weekdayName returns the name of wd in locale, see monthName
+func weekdayName(wd time.Weekday, locale string, abbr, standalone bool) string {
+	switch locale {
+	case LocaleSvSE:
+		s := strings.ToLower(WeekdaysSvSE[wd])
+		if abbr {
+			s = WeekdaysAbbrSvSE[wd]
+		}
+		if standalone {
+			s = ucFirst(s)
+		}
+		return s
+	}
+	if abbr {
+		return WeekdaysAbbrEnUS[wd]
+	}
+	return WeekdaysEnUS[wd]
+}
+
+func dayPeriodName(hour int, locale string) string {
+	switch locale {
+	case LocaleSvSE:
+		if hour < 12 {
+			return "fm"
+		}
+		return "em"
+	}
+	if hour < 12 {
+		return "AM"
+	}
+	return "PM"
+}
+
+// FormatDate formats t using a CLDR style pattern such as "EEEE d MMMM y",
+// which gives "måndag 13 december 2021" in Swedish. Supported letters are
+// y, M, L, d, E, c, H, h, m, s and a
+func FormatDate(t time.Time, pattern string, locale string) string {
+	s := ""
+	for _, tok := range tokenizePattern(pattern) {
+		if tok.letter == 0 {
+			s += tok.literal
+			continue
+		}
+		switch tok.letter {
+		case 'y':
+			if tok.count == 2 {
+				s += fmt.Sprintf("%02d", t.Year()%100)
+			} else {
+				s += fmt.Sprintf("%0*d", tok.count, t.Year())
+			}
+		case 'M', 'L':
+			switch tok.count {
+			case 1, 2:
+				s += fmt.Sprintf("%0*d", tok.count, t.Month())
+			default:
+				s += monthName(t.Month(), locale, tok.count == 3, tok.letter == 'L')
+			}
+		case 'd':
+			s += fmt.Sprintf("%0*d", tok.count, t.Day())
+		case 'E', 'c':
+			s += weekdayName(t.Weekday(), locale, tok.count < 4, tok.letter == 'c')
+		case 'H':
+			s += fmt.Sprintf("%0*d", tok.count, t.Hour())
+		case 'h':
+			s += fmt.Sprintf("%0*d", tok.count, hour12(t.Hour()))
+		case 'm':
+			s += fmt.Sprintf("%0*d", tok.count, t.Minute())
+		case 's':
+			s += fmt.Sprintf("%0*d", tok.count, t.Second())
+		case 'a':
+			s += dayPeriodName(t.Hour(), locale)
+		default:
+			s += strings.Repeat(string(tok.letter), tok.count)
+		}
+	}
+	return s
+}
+
+// ParseDate parses s according to a CLDR style pattern, the reverse of FormatDate.
+// Fields missing from the pattern default to the current year, January, day 1, 00:00
+func ParseDate(s string, pattern string, locale string) (time.Time, error) {
+	tokens := tokenizePattern(pattern)
+	expr := "^"
+	for _, tok := range tokens {
+		if tok.letter == 0 {
+			expr += regexp.QuoteMeta(tok.literal)
+			continue
+		}
+		switch tok.letter {
+		case 'y':
+			if tok.count == 2 {
+				expr += `(\d{2})`
+			} else {
+				expr += `(\d{1,4})`
+			}
+		case 'M', 'L':
+			if tok.count <= 2 {
+				expr += `(\d{1,2})`
+			} else {
+				expr += `([\pL.]+)`
+			}
+		case 'E', 'c':
+			expr += `([\pL.]+)`
+		case 'd', 'H', 'h', 'm', 's':
+			expr += `(\d{1,2})`
+		case 'a':
+			expr += `([\pL.]+)`
+		default:
+			expr += regexp.QuoteMeta(strings.Repeat(string(tok.letter), tok.count))
+		}
+	}
+	expr += "$"
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	match := re.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return time.Time{}, fmt.Errorf("Cannot parse '%s' as '%s'", s, pattern)
+	}
+
+	year, month, day := time.Now().Year(), time.January, 1
+	hour, min, sec := 0, 0, 0
+	pm, hasPeriod := false, false
+	weekday, hasWeekday := time.Sunday, false
+
+	i := 1
+	for _, tok := range tokens {
+		if tok.letter == 0 || !strings.ContainsRune("yMLdEcHhmsa", tok.letter) {
+			continue
+		}
+		val := match[i]
+		i++
+		switch tok.letter {
+		case 'y':
+			if tok.count == 2 {
+				year, err = ParseYear(val)
+			} else {
+				year, err = strconv.Atoi(val)
+			}
+		case 'M', 'L':
+			if tok.count <= 2 {
+				var n int
+				n, err = strconv.Atoi(val)
+				month = time.Month(n)
+			} else {
+				month, err = ParseMonth(strings.TrimSuffix(val, "."))
+			}
+		case 'd':
+			day, err = strconv.Atoi(val)
+		case 'E', 'c':
+			weekday, err = ParseWeekday(strings.TrimSuffix(val, "."))
+			hasWeekday = true
+		case 'H', 'h':
+			hour, err = strconv.Atoi(val)
+		case 'm':
+			min, err = strconv.Atoi(val)
+		case 's':
+			sec, err = strconv.Atoi(val)
+		case 'a':
+			switch strings.ToLower(strings.Replace(val, ".", "", -1)) {
+			case "am", "fm":
+			case "pm", "em":
+				pm = true
+			default:
+				err = fmt.Errorf("Cannot parse day period: %s", val)
+			}
+			hasPeriod = true
+		}
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	if hasPeriod {
+		hour = hour % 12
+		if pm {
+			hour += 12
+		}
+	}
+	if month < time.January || month > time.December {
+		return time.Time{}, fmt.Errorf("Invalid month: %d", month)
+	}
+	t := time.Date(year, month, day, hour, min, sec, 0, time.Local)
+	if t.Day() != day || hour > 23 || min > 59 || sec > 59 {
+		return time.Time{}, fmt.Errorf("Invalid date: %s", s)
+	}
+	if hasWeekday && t.Weekday() != weekday {
+		return time.Time{}, fmt.Errorf("%s is not a %s", t.Format("2006-01-02"), weekday)
+	}
+	return t, nil
+}
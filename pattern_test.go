@@ -0,0 +1,85 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDate(t *testing.T) {
+	tm := time.Date(2021, time.December, 13, 18, 5, 9, 0, time.UTC)
+
+	expectedSV := map[string]string{
+		// pattern, expected output
+		"EEEE d MMMM y":        "måndag 13 december 2021",
+		"d MMM":                "13 dec",
+		"EEE d MMM yy":         "mån 13 dec 21",
+		"cccc":                 "Måndag",
+		"LLLL y":               "December 2021",
+		"yyyy-MM-dd HH:mm:ss":  "2021-12-13 18:05:09",
+		"'kl' H.mm":            "kl 18.05",
+		"h:mm a":               "6:05 em",
+		"'den' d MMMM 'i''år'": "den 13 december i'år",
+	}
+	for pattern, expect := range expectedSV {
+		assert.Equal(t, expect, FormatDate(tm, pattern, LocaleSvSE), pattern)
+	}
+
+	expectedEN := map[string]string{
+		// pattern, expected output
+		"EEEE, MMMM d, y": "Monday, December 13, 2021",
+		"EEE d MMM":       "Mon 13 Dec",
+		"h:mm a":          "6:05 PM",
+		"M/d/yy":          "12/13/21",
+	}
+	for pattern, expect := range expectedEN {
+		assert.Equal(t, expect, FormatDate(tm, pattern, LocaleEnUS), pattern)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	expected := time.Date(2021, time.December, 13, 0, 0, 0, 0, time.Local)
+
+	tm, err := ParseDate("måndag 13 december 2021", "EEEE d MMMM y", LocaleSvSE)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, expected, tm)
+
+	tm, err = ParseDate("Monday, December 13, 2021", "EEEE, MMMM d, y", LocaleEnUS)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, expected, tm)
+
+	tm, err = ParseDate("13 dec", "d MMM", LocaleSvSE)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, time.Now().Year(), tm.Year())
+	assert.Equal(t, time.December, tm.Month())
+	assert.Equal(t, 13, tm.Day())
+
+	tm, err = ParseDate("2021-12-13 6:05 em", "yyyy-MM-dd h:mm a", LocaleSvSE)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 18, tm.Hour())
+	assert.Equal(t, 5, tm.Minute())
+
+	// wrong weekday
+	_, err = ParseDate("tisdag 13 december 2021", "EEEE d MMMM y", LocaleSvSE)
+	assert.NotEqual(t, nil, err)
+
+	// impossible date
+	_, err = ParseDate("31 februari 2021", "d MMMM y", LocaleSvSE)
+	assert.NotEqual(t, nil, err)
+
+	_, err = ParseDate("13 december", "d MMMM y", LocaleSvSE)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestFormatDateParses(t *testing.T) {
+	tm := time.Date(2024, time.March, 28, 9, 30, 0, 0, time.Local)
+	for _, locale := range []string{LocaleSvSE, LocaleEnUS} {
+		for _, pattern := range []string{"EEEE d MMMM y HH:mm", "EEE d MMM yy", "cccc d LLL y"} {
+			s := FormatDate(tm, pattern, locale)
+			res, err := ParseDate(s, pattern, locale)
+			assert.Equal(t, nil, err, s)
+			assert.Equal(t, tm.Format("2006-01-02"), res.Format("2006-01-02"), s)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	// "nästa vecka", "förra månaden", "i helgen", "this quarter"
+	periodRegex = regexp.MustCompile(`^(denna|detta|den här|nästa|förra|i|this|next|last|previous) (\pL+)$`)
+
+	// "i höst", "i somras", "next summer"
+	seasonRegex = regexp.MustCompile(`^(i|this|next|last) (\pL+)$`)
+
+	periodOffsets = map[string]int{
+		"denna": 0, "detta": 0, "den här": 0, "i": 0, "this": 0,
+		"nästa": 1, "next": 1,
+		"förra": -1, "last": -1, "previous": -1,
+	}
+
+	periodUnits = map[string]string{
+		// swe
+		"vecka": "week", "veckan": "week",
+		"månad": "month", "månaden": "month",
+		"år": "year", "året": "year",
+		"helg": "weekend", "helgen": "weekend",
+		"kvartal": "quarter", "kvartalet": "quarter",
+		// eng
+		"week": "week", "month": "month", "year": "year",
+		"weekend": "weekend", "quarter": "quarter",
+	}
+
+	// https://sv.wikipedia.org/wiki/%C3%85rstid
+	seasonStartMonths = map[string]time.Month{
+		// swe
+		"vår": time.March, "sommar": time.June, "höst": time.September, "vinter": time.December,
+		// eng
+		"spring": time.March, "summer": time.June, "autumn": time.September, "fall": time.September, "winter": time.December,
+	}
+
+	// "i början av nästa månad", "mitten av mars", "end of the year", "mid-may"
+	periodPositionRegex = regexp.MustCompile(`^(?:i |in the |the |at the )?(början av|mitten av|slutet av|early|mid|late|beginning of|start of|middle of|end of) (.+)$`)
+
+	// "vid månadsskiftet", "at the turn of the year"
+	periodTurnRegex = regexp.MustCompile(`^(?:vid |runt |kring |at the |around the )?(månadsskiftet|årsskiftet|turn of the month|turn of the year)$`)
+
+	// "mars", "mars 2025", "may, 2025"
+	periodMonthRegex = regexp.MustCompile(`^(\pL+)(?:,? (\d{4}))?$`)
+
+	periodPositions = map[string]int{
+		"början av": 0, "early": 0, "beginning of": 0, "start of": 0,
+		"mitten av": 1, "mid": 1, "middle of": 1,
+		"slutet av": 2, "late": 2, "end of": 2,
+	}
+
+	// swe: "i våras", "i somras", "i höstas", "i vintras"
+	pastSeasonsSvSE = map[string]string{
+		"våras": "vår", "somras": "sommar", "höstas": "höst", "vintras": "vinter",
+	}
+)
+
+// ParsePeriod parses a named calendar period relative to the current time,
+// see Parser.ParsePeriod
+func ParsePeriod(s string) (Interval, error) {
+	return NewParser(LocaleSvSE).ParsePeriod(s)
+}
+
+// ParsePeriod parses named calendar periods like "nästa vecka", "förra månaden",
+// "i helgen", "i höst", "i fjol", "häromdagen" or "this quarter" into an interval
+// relative to the reference time. Weeks begin on the first day of the week in the
+// parser locale. Periods can be narrowed with "i början av", "i mitten av", "i
+// slutet av", "early", "mid", "late" and similar, as in "i slutet av mars" or "end
+// of the year". Decades and centuries like "80-talet" are parsed by ParseEra
+func (p *Parser) ParsePeriod(s string) (Interval, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.Replace(s, "mid-", "mid ", 1)
+	now := p.now()
+
+	if s == "i fjol" || s == "ifjol" {
+		return p.period("year", -1, now), nil
+	}
+
+	// "häromdagen": a few days ago, not yesterday
+	if s == "häromdagen" || s == "the other day" {
+		return Interval{Start: addDay(now, -7), End: addDay(now, -1)}, nil
+	}
+
+	// "i början av nästa månad", "end of the year"
+	if match := periodPositionRegex.FindStringSubmatch(s); match != nil {
+		// "end of the year", "i slutet av året"
+		inner := strings.TrimPrefix(match[2], "the ")
+		if unit, ok := periodUnits[inner]; ok {
+			return periodPosition(p.period(unit, 0, now), periodPositions[match[1]]), nil
+		}
+		i, err := p.ParsePeriod(inner)
+		if err != nil {
+			return i, err
+		}
+		return periodPosition(i, periodPositions[match[1]]), nil
+	}
+
+	// "vid månadsskiftet": the last and first three days around the turn
+	if match := periodTurnRegex.FindStringSubmatch(s); match != nil {
+		i := p.period("month", 0, now)
+		if match[1] == "årsskiftet" || match[1] == "turn of the year" {
+			i = p.period("year", 0, now)
+		}
+		return Interval{Start: addDay(i.End, -3), End: addDay(i.End, 3)}, nil
+	}
+
+	// "2025"
+	if len(s) == 4 && isNumericString(s) {
+		year, err := ParseYear(s)
+		if err != nil {
+			return Interval{}, err
+		}
+		return monthInterval(year, time.January, 12, now.Location()), nil
+	}
+
+	// "vecka 12"
+	if weekRegex.MatchString(s) {
+		start, err := p.ParseWeek(s)
+		if err != nil {
+			return Interval{}, err
+		}
+		return dayInterval(start, 7), nil
+	}
+
+	if match := periodRegex.FindStringSubmatch(s); match != nil {
+		if unit, ok := periodUnits[match[2]]; ok {
+			return p.period(unit, periodOffsets[match[1]], now), nil
+		}
+	}
+
+	if match := seasonRegex.FindStringSubmatch(s); match != nil {
+		if season, ok := pastSeasonsSvSE[match[2]]; ok && match[1] == "i" {
+			return seasonInterval(seasonStartMonths[season], "past", now), nil
+		}
+		if month, ok := seasonStartMonths[match[2]]; ok {
+			return seasonInterval(month, match[1], now), nil
+		}
+	}
+
+	// "mars", "mars 2025"
+	if match := periodMonthRegex.FindStringSubmatch(s); match != nil {
+		if month, err := ParseMonth(match[1]); err == nil {
+			if match[2] == "" {
+				return p.prefer(monthInterval(now.Year(), month, 1, now.Location()), now, 1, 0), nil
+			}
+			year, err := ParseYear(match[2])
+			if err != nil {
+				return Interval{}, err
+			}
+			return monthInterval(year, month, 1, now.Location()), nil
+		}
+	}
+
+	// "80-talet", "the 19th century", "200 f.Kr."
+	if i, err := p.ParseEra(s); err == nil {
+		return i, nil
+	}
+
+	// "Q3 2024", "tredje kvartalet", "FY25"
+	if i, err := p.ParseFiscalPeriod(s); err == nil {
+		return i, nil
+	}
+
+	return Interval{}, fmt.Errorf("Cannot parse period: %s", s)
+}
+
+// periodPosition returns the first (0), middle (1) or last (2) third of i.
+// Periods of whole months are split on month boundaries, others on days
+func periodPosition(i Interval, pos int) Interval {
+	months := (i.End.Year()-i.Start.Year())*12 + int(i.End.Month()-i.Start.Month())
+	if months >= 3 && i.Start.Day() == 1 && i.End.Day() == 1 {
+		third := months / 3
+		start := i.Start.AddDate(0, third*pos, 0)
+		end := start.AddDate(0, third, 0)
+		if pos == 2 {
+			end = i.End
+		}
+		return Interval{Start: start, End: end}
+	}
+
+	days := int(i.End.Sub(i.Start).Hours()+12) / 24
+	third := days / 3
+	if third == 0 {
+		return i
+	}
+	switch pos {
+	case 0:
+		return Interval{Start: i.Start, End: addDay(i.Start, third)}
+	case 1:
+		return Interval{Start: addDay(i.Start, third), End: addDay(i.End, -third)}
+	}
+	return Interval{Start: addDay(i.End, -third), End: i.End}
+}
+
+// period returns the unit ("week", "month" ...) containing now, moved offset units
+func (p *Parser) period(unit string, offset int, now time.Time) Interval {
+	switch unit {
+	case "week":
+		start := addDay(StartOfWeek(now, p.Locale), offset*7)
+		return Interval{Start: start, End: addDay(start, 7)}
+	case "weekend":
+		// the current weekend, or the coming one
+		sat := addDay(now, int(time.Saturday-now.Weekday()))
+		if now.Weekday() == time.Sunday {
+			sat = addDay(now, -1)
+		}
+		return dayInterval(addDay(sat, offset*7), 2)
+	case "month":
+		return monthInterval(now.Year(), now.Month()+time.Month(offset), 1, now.Location())
+	case "quarter":
+		// quarters are counted from the start of the fiscal year
+		start := p.fiscalYearStart(p.fiscalYear(now), now.Location())
+		months := (now.Year()-start.Year())*12 + int(now.Month()-start.Month())
+		return monthInterval(start.Year(), start.Month()+time.Month((months/3+offset)*3), 3, now.Location())
+	}
+	return monthInterval(now.Year()+offset, time.January, 12, now.Location())
+}
+
+// seasonInterval returns the season beginning in month. which is "i" or "this" for
+// the current or coming season, "next" for the coming one, "last" for the last
+// finished one and "past" for the most recently begun one
+func seasonInterval(month time.Month, which string, now time.Time) Interval {
+	candidates := []Interval{}
+	for y := now.Year() - 2; y <= now.Year()+1; y++ {
+		candidates = append(candidates, monthInterval(y, month, 3, now.Location()))
+	}
+
+	res := candidates[0]
+	for _, c := range candidates {
+		switch which {
+		case "next":
+			if c.Start.After(now) {
+				return c
+			}
+		case "last":
+			if !c.End.After(now) {
+				res = c
+			}
+		case "past":
+			if !c.Start.After(now) {
+				res = c
+			}
+		default:
+			if c.End.After(now) {
+				return c
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,109 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// wednesday 2024-04-17 15:30
+func testParser(locale string) *Parser {
+	p := NewParser(locale)
+	p.Now = func() time.Time {
+		return time.Date(2024, time.April, 17, 15, 30, 0, 0, time.UTC)
+	}
+	return p
+}
+
+func TestParsePeriod(t *testing.T) {
+	expected := map[string]string{
+		// swe
+		"denna vecka":     "2024-04-15 00:00 - 2024-04-22 00:00",
+		"i veckan":        "2024-04-15 00:00 - 2024-04-22 00:00",
+		"nästa vecka":     "2024-04-22 00:00 - 2024-04-29 00:00",
+		"förra veckan":    "2024-04-08 00:00 - 2024-04-15 00:00",
+		"den här månaden": "2024-04-01 00:00 - 2024-05-01 00:00",
+		"förra månaden":   "2024-03-01 00:00 - 2024-04-01 00:00",
+		"nästa månad":     "2024-05-01 00:00 - 2024-06-01 00:00",
+		"i år":            "2024-01-01 00:00 - 2025-01-01 00:00",
+		"förra året":      "2023-01-01 00:00 - 2024-01-01 00:00",
+		"i fjol":          "2023-01-01 00:00 - 2024-01-01 00:00",
+		"i helgen":        "2024-04-20 00:00 - 2024-04-22 00:00",
+		"förra helgen":    "2024-04-13 00:00 - 2024-04-15 00:00",
+		"detta kvartal":   "2024-04-01 00:00 - 2024-07-01 00:00",
+		"förra kvartalet": "2024-01-01 00:00 - 2024-04-01 00:00",
+		"i höst":          "2024-09-01 00:00 - 2024-12-01 00:00",
+		"i vår":           "2024-03-01 00:00 - 2024-06-01 00:00",
+		"i höstas":        "2023-09-01 00:00 - 2023-12-01 00:00",
+		"i vintras":       "2023-12-01 00:00 - 2024-03-01 00:00",
+		"i vinter":        "2024-12-01 00:00 - 2025-03-01 00:00",
+		"häromdagen":      "2024-04-10 00:00 - 2024-04-16 00:00",
+		// eng
+		"this week":     "2024-04-15 00:00 - 2024-04-22 00:00",
+		"last month":    "2024-03-01 00:00 - 2024-04-01 00:00",
+		"next year":     "2025-01-01 00:00 - 2026-01-01 00:00",
+		"this weekend":  "2024-04-20 00:00 - 2024-04-22 00:00",
+		"next quarter":  "2024-07-01 00:00 - 2024-10-01 00:00",
+		"last summer":   "2023-06-01 00:00 - 2023-09-01 00:00",
+		"next spring":   "2025-03-01 00:00 - 2025-06-01 00:00",
+		"the other day": "2024-04-10 00:00 - 2024-04-16 00:00",
+	}
+	p := testParser(LocaleSvSE)
+	for s, expect := range expected {
+		i, err := p.ParsePeriod(s)
+		assert.Equal(t, nil, err, s)
+		assert.Equal(t, expect, i.String(), s)
+	}
+
+	_, err := p.ParsePeriod("nästa decennium")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestParsePeriodWeekStart(t *testing.T) {
+	i, err := testParser(LocaleEnUS).ParsePeriod("this week")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-04-14 00:00 - 2024-04-21 00:00", i.String())
+
+	// on a sunday, the weekend is the current one
+	p := NewParser(LocaleSvSE)
+	p.Now = func() time.Time {
+		return time.Date(2024, time.April, 21, 10, 0, 0, 0, time.UTC)
+	}
+	i, err = p.ParsePeriod("i helgen")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-04-20 00:00 - 2024-04-22 00:00", i.String())
+	assert.Equal(t, true, i.Contains(p.Now()))
+	assert.Equal(t, 48*time.Hour, i.Duration())
+}
+
+func TestParsePeriodPosition(t *testing.T) {
+	expected := map[string]string{
+		// swe
+		"i början av nästa månad":  "2024-05-01 00:00 - 2024-05-11 00:00",
+		"mitten av mars":           "2024-03-11 00:00 - 2024-03-22 00:00",
+		"i slutet av mars 2025":    "2025-03-22 00:00 - 2025-04-01 00:00",
+		"i slutet av året":         "2024-09-01 00:00 - 2025-01-01 00:00",
+		"i början av 2025":         "2025-01-01 00:00 - 2025-05-01 00:00",
+		"i mitten av förra veckan": "2024-04-10 00:00 - 2024-04-13 00:00",
+		"i slutet av vecka 12":     "2024-03-23 00:00 - 2024-03-25 00:00",
+		"vid månadsskiftet":        "2024-04-28 00:00 - 2024-05-04 00:00",
+		"vid årsskiftet":           "2024-12-29 00:00 - 2025-01-04 00:00",
+		// eng
+		"early may":                     "2024-05-01 00:00 - 2024-05-11 00:00",
+		"mid-may":                       "2024-05-11 00:00 - 2024-05-22 00:00",
+		"late april":                    "2024-04-21 00:00 - 2024-05-01 00:00",
+		"end of the year":               "2024-09-01 00:00 - 2025-01-01 00:00",
+		"the beginning of next quarter": "2024-07-01 00:00 - 2024-08-01 00:00",
+		"end of the month":              "2024-04-21 00:00 - 2024-05-01 00:00",
+	}
+	p := testParser(LocaleSvSE)
+	for s, expect := range expected {
+		i, err := p.ParsePeriod(s)
+		assert.Equal(t, nil, err, s)
+		assert.Equal(t, expect, i.String(), s)
+	}
+
+	_, err := p.ParsePeriod("i slutet av tiden")
+	assert.NotEqual(t, nil, err)
+}
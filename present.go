@@ -151,6 +151,9 @@ func presentEN(num int64) (string, error) {
 
 // PresentListSvSE presents a list of strings as "a, b och c"
 func PresentListSvSE(list []string) string {
+	if len(list) == 1 {
+		return list[0]
+	}
 	last := list[len(list)-1]
 	rest := list[0 : len(list)-1]
 	return strings.Join(rest, ", ") + " och " + last
@@ -158,6 +161,9 @@ func PresentListSvSE(list []string) string {
 
 // PresentListEnUS presents a list of strings as "a, b and c"
 func PresentListEnUS(list []string) string {
+	if len(list) == 1 {
+		return list[0]
+	}
 	last := list[len(list)-1]
 	rest := list[0 : len(list)-1]
 	return strings.Join(rest, ", ") + " and " + last
@@ -58,11 +58,13 @@ func TestPresentEN(t *testing.T) {
 }
 
 func TestPresentListSV(t *testing.T) {
+	assert.Equal(t, "trims", PresentListSvSE([]string{"trims"}))
 	assert.Equal(t, "trims och trams", PresentListSvSE([]string{"trims", "trams"}))
 	assert.Equal(t, "trims, trams och trums", PresentListSvSE([]string{"trims", "trams", "trums"}))
 }
 
 func TestPresentListEN(t *testing.T) {
+	assert.Equal(t, "trims", PresentListEnUS([]string{"trims"}))
 	assert.Equal(t, "trims and trams", PresentListEnUS([]string{"trims", "trams"}))
 	assert.Equal(t, "trims, trams and trums", PresentListEnUS([]string{"trims", "trams", "trums"}))
 }
@@ -0,0 +1,366 @@
+package natural
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateStyle selects how PresentDate renders a date
+type DateStyle int
+
+// Base styles, pick one
+const (
+	// DateShort renders "13/12", "12/13"
+	DateShort DateStyle = iota
+	// DateMedium renders "13 december", "December 13"
+	DateMedium
+	// DateLong renders "13:e december", "December 13th"
+	DateLong
+	// DateFull renders "måndagen den 13:e december", "Monday, December 13th"
+	DateFull
+)
+
+// Flags, combine with a base style
+const (
+	// DateWithWeekday prefixes the weekday
+	DateWithWeekday DateStyle = 1 << (iota + 4)
+	// DateWithYear includes the year even when it is the current year
+	DateWithYear
+	// DateSpelledOut writes day and year in words, for text to speech
+	DateSpelledOut
+)
+
+const dateBaseStyleMask = 0xf
+
+// PresentDate returns t as a date in locale, such as "måndagen den 13:e december 2021"
+// or "Monday, December 13th, 2021". The year is left out if it is the current year
+func PresentDate(t time.Time, locale string, style DateStyle) string {
+	return presentDate(t, locale, style, time.Now())
+}
+
+// presentDate presents t, leaving out the year if it is the year of now
+func presentDate(t time.Time, locale string, style DateStyle, now time.Time) string {
+	base := style & dateBaseStyleMask
+	withWeekday := base == DateFull || style&DateWithWeekday != 0
+	withYear := style&DateWithYear != 0 || t.Year() != now.Year()
+	spelled := style&DateSpelledOut != 0
+
+	switch locale {
+	case LocaleSvSE:
+		return presentDateSvSE(t, base, withWeekday, withYear, spelled)
+	}
+	return presentDateEnUS(t, base, withWeekday, withYear, spelled)
+}
+
+func presentDateSvSE(t time.Time, base DateStyle, withWeekday, withYear, spelled bool) string {
+	weekday := strings.ToLower(WeekdaysSvSE[t.Weekday()])
+	month := strings.ToLower(MonthsSvSE[t.Month()])
+	year := fmt.Sprintf("%d", t.Year())
+	if spelled {
+		year = presentYearSvSE(t.Year())
+	}
+
+	s := ""
+	switch {
+	case base == DateShort && !spelled:
+		if withYear {
+			s = fmt.Sprintf("%d-%02d-%02d", t.Year(), t.Month(), t.Day())
+		} else {
+			s = fmt.Sprintf("%d/%d", t.Day(), t.Month())
+		}
+		withYear = false
+	case base == DateMedium && !spelled:
+		s = fmt.Sprintf("%d %s", t.Day(), month)
+	case spelled:
+		s = "den " + PresentCountSwedish(t.Day()) + " " + month
+	default:
+		s = "den " + PresentCountShortSwedish(t.Day()) + " " + month
+	}
+
+	if withYear {
+		s += " " + year
+	}
+	if !withWeekday && !spelled {
+		return strings.TrimPrefix(s, "den ")
+	}
+	if !withWeekday {
+		return s
+	}
+	if strings.HasPrefix(s, "den ") {
+		// "måndagen den 13:e december"
+		return weekday + "en " + s
+	}
+	return weekday + " " + s
+}
+
+func presentDateEnUS(t time.Time, base DateStyle, withWeekday, withYear, spelled bool) string {
+	weekday := WeekdaysEnUS[t.Weekday()]
+	month := t.Month().String()
+	year := fmt.Sprintf("%d", t.Year())
+	if spelled {
+		year = presentYearEnUS(t.Year())
+	}
+
+	s := ""
+	switch {
+	case base == DateShort && !spelled:
+		s = fmt.Sprintf("%d/%d", t.Month(), t.Day())
+		if withYear {
+			s += fmt.Sprintf("/%d", t.Year())
+		}
+		withYear = false
+	case base == DateMedium && !spelled:
+		s = fmt.Sprintf("%s %d", month, t.Day())
+	case spelled:
+		s = month + " " + PresentCountEnglish(t.Day())
+	default:
+		// "13:th" => "13th"
+		s = month + " " + strings.Replace(PresentCountShortEnglish(t.Day()), ":", "", 1)
+	}
+
+	if withYear {
+		s += ", " + year
+	}
+	if withWeekday {
+		return weekday + ", " + s
+	}
+	return s
+}
+
+// presentYearSvSE returns the year as read aloud, 2021 = "tjugohundratjugoett"
+func presentYearSvSE(year int) string {
+	if year < 1100 || year%1000 < 10 {
+		// "tvåtusen", "tvåtusenfem"
+		return strings.Replace(PresentSvSE(int64(year)), " ", "", -1)
+	}
+	return PresentSvSE(int64(year/100)) + "hundra" + PresentSvSE(int64(year%100))
+}
+
+// presentYearEnUS returns the year as read aloud, 2021 = "twenty twenty-one"
+func presentYearEnUS(year int) string {
+	if year < 1100 || year%1000 < 10 {
+		// "two thousand", "two thousand five"
+		return PresentEnUS(int64(year))
+	}
+	hi := PresentEnUS(int64(year / 100))
+	lo := year % 100
+	switch {
+	case lo == 0:
+		return hi + " hundred"
+	case lo < 10:
+		return hi + " oh " + PresentEnUS(int64(lo))
+	}
+	return hi + " " + PresentEnUS(int64(lo))
+}
+
+// PresentDateRange returns the range from start to end in its shortest form, such as
+// "3–5 maj 2024", "30 april – 2 maj", "May 3–5" or "kl 9–17". Like Interval, end is not
+// included, so whole days end at midnight after the last day. Shared month and year
+// are written once, and the year is left out if it is the current year
+func PresentDateRange(start, end time.Time, locale string, style DateStyle) string {
+	return presentDateRange(start, end, locale, style, time.Now())
+}
+
+// presentDateRange presents the range, leaving out the year if it is the year of now
+func presentDateRange(start, end time.Time, locale string, style DateStyle, now time.Time) string {
+	sv := locale == LocaleSvSE
+	if !end.After(start) {
+		end = start
+	}
+
+	if !isMidnight(start) || !isMidnight(end) {
+		return presentClockRange(start, end, locale, style, now)
+	}
+
+	last := end
+	if end.After(start) {
+		last = addDay(end, -1)
+	}
+	if last.Year() == start.Year() && last.YearDay() == start.YearDay() {
+		return presentDate(start, locale, style, now)
+	}
+
+	base := style & dateBaseStyleMask
+	withWeekday := base == DateFull || style&DateWithWeekday != 0
+	withYear := style&DateWithYear != 0 || start.Year() != now.Year() || last.Year() != now.Year()
+	spelled := style&DateSpelledOut != 0
+	sameYear := start.Year() == last.Year()
+	sameMonth := sameYear && start.Month() == last.Month()
+
+	if spelled {
+		return presentSpelledDateRange(start, last, locale, withYear, sameYear, sameMonth)
+	}
+
+	if base == DateShort {
+		switch {
+		case sv && withYear:
+			return start.Format("2006-01-02") + " – " + last.Format("2006-01-02")
+		case sv && sameMonth:
+			return fmt.Sprintf("%d–%d/%d", start.Day(), last.Day(), last.Month())
+		case sv:
+			return fmt.Sprintf("%d/%d–%d/%d", start.Day(), start.Month(), last.Day(), last.Month())
+		case withYear:
+			return fmt.Sprintf("%d/%d/%d – %d/%d/%d", start.Month(), start.Day(), start.Year(), last.Month(), last.Day(), last.Year())
+		}
+		return fmt.Sprintf("%d/%d–%d/%d", start.Month(), start.Day(), last.Month(), last.Day())
+	}
+
+	day := func(t time.Time) string {
+		d := fmt.Sprintf("%d", t.Day())
+		if base >= DateLong {
+			if sv {
+				d = PresentCountShortSwedish(t.Day())
+			} else {
+				d = strings.Replace(PresentCountShortEnglish(t.Day()), ":", "", 1)
+			}
+		}
+		if withWeekday && sv {
+			return strings.ToLower(WeekdaysSvSE[t.Weekday()]) + " " + d
+		}
+		return d
+	}
+
+	if sv {
+		month := func(t time.Time) string { return strings.ToLower(MonthsSvSE[t.Month()]) }
+		from, to := day(start)+" "+month(start), day(last)+" "+month(last)
+		if withYear {
+			to += fmt.Sprintf(" %d", last.Year())
+		}
+		switch {
+		case sameMonth && !withWeekday:
+			// "3–5 maj"
+			return day(start) + "–" + to
+		case sameMonth:
+			// "fredag 3 – söndag 5 maj"
+			from = day(start)
+		case !sameYear:
+			from += fmt.Sprintf(" %d", start.Year())
+		}
+		return joinRange(from, to)
+	}
+
+	weekday := func(t time.Time) string {
+		if withWeekday {
+			return WeekdaysEnUS[t.Weekday()] + ", "
+		}
+		return ""
+	}
+	from := weekday(start) + start.Month().String() + " " + day(start)
+	to := weekday(last) + last.Month().String() + " " + day(last)
+	year := ""
+	if withYear {
+		year = fmt.Sprintf(", %d", last.Year())
+	}
+	switch {
+	case sameMonth && !withWeekday:
+		// "May 3–5"
+		return from + "–" + day(last) + year
+	case !sameYear:
+		from += fmt.Sprintf(", %d", start.Year())
+	}
+	return joinRange(from, to+year)
+}
+
+// presentSpelledDateRange returns "den tredje till den femte maj" or "May third to fifth"
+func presentSpelledDateRange(start, last time.Time, locale string, withYear, sameYear, sameMonth bool) string {
+	if locale == LocaleSvSE {
+		month := func(t time.Time) string { return " " + strings.ToLower(MonthsSvSE[t.Month()]) }
+		from := "den " + PresentCountSwedish(start.Day())
+		to := "den " + PresentCountSwedish(last.Day()) + month(last)
+		if !sameMonth {
+			from += month(start)
+		}
+		if !sameYear {
+			from += " " + presentYearSvSE(start.Year())
+		}
+		if withYear {
+			to += " " + presentYearSvSE(last.Year())
+		}
+		return from + " till " + to
+	}
+
+	from := start.Month().String() + " " + PresentCountEnglish(start.Day())
+	to := last.Month().String() + " " + PresentCountEnglish(last.Day())
+	if sameMonth {
+		to = PresentCountEnglish(last.Day())
+	}
+	if !sameYear {
+		from += ", " + presentYearEnUS(start.Year())
+	}
+	if withYear {
+		to += ", " + presentYearEnUS(last.Year())
+	}
+	return from + " to " + to
+}
+
+// presentClockRange returns "kl 9–17", "3 maj kl 9–17", "3–4 maj kl 22–2" or
+// "May 3, 9 AM – May 5, 5 PM"
+func presentClockRange(start, end time.Time, locale string, style DateStyle, now time.Time) string {
+	sv := locale == LocaleSvSE
+	style &^= DateSpelledOut
+	clock := func(t time.Time) string {
+		if sv {
+			return clockSvSEDigits(t.Hour()*60 + t.Minute())
+		}
+		return clockEnUSDigits(t.Hour()*60 + t.Minute())
+	}
+	sameDay := start.Year() == end.Year() && start.YearDay() == end.YearDay()
+	if !sameDay && isMidnight(end) && end.Sub(start) < 24*time.Hour {
+		// "22–24"
+		sameDay = true
+	}
+	today := beginningOfDay(start).Equal(beginningOfDay(now.In(start.Location())))
+
+	if !sameDay && end.Sub(start) < 24*time.Hour {
+		// over midnight, "3–4 maj kl 22–2", "May 3–4, 10 PM–2 AM"
+		days := presentDateRange(beginningOfDay(start), addDay(end, 1), locale, style, now)
+		if sv {
+			return days + " kl " + clock(start) + "–" + clock(end)
+		}
+		return days + ", " + clock(start) + "–" + clock(end)
+	}
+
+	from := presentDate(start, locale, style, now)
+	to := presentDate(end, locale, style|DateWithYear, now)
+	if start.Year() == end.Year() {
+		// the year is written once, on the start
+		to = presentDate(end, locale, style&^DateWithYear, end)
+	}
+
+	if sv {
+		if sameDay {
+			to := clock(end)
+			if isMidnight(end) && end.After(start) {
+				to = "24"
+			}
+			s := "kl " + clock(start) + "–" + to
+			if today {
+				return s
+			}
+			return from + " " + s
+		}
+		return from + " kl " + clock(start) + " – " + to + " kl " + clock(end)
+	}
+
+	if sameDay {
+		s := clock(start) + "–" + clock(end)
+		if today {
+			return s
+		}
+		return from + ", " + s
+	}
+	return from + ", " + clock(start) + " – " + to + ", " + clock(end)
+}
+
+// joinRange joins with an en dash, spaced if either side has spaces
+func joinRange(from, to string) string {
+	if strings.Contains(from, " ") || strings.Contains(to, " ") {
+		return from + " – " + to
+	}
+	return from + "–" + to
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
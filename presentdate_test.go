@@ -0,0 +1,115 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresentDateSV(t *testing.T) {
+	past := time.Date(2021, time.December, 13, 0, 0, 0, 0, time.UTC)
+	this := time.Date(time.Now().Year(), time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2021-12-13", PresentDate(past, LocaleSvSE, DateShort))
+	assert.Equal(t, "1/3", PresentDate(this, LocaleSvSE, DateShort))
+	assert.Equal(t, "13 december 2021", PresentDate(past, LocaleSvSE, DateMedium))
+	assert.Equal(t, "1 mars", PresentDate(this, LocaleSvSE, DateMedium))
+	assert.Equal(t, "13:e december 2021", PresentDate(past, LocaleSvSE, DateLong))
+	assert.Equal(t, "1:a mars", PresentDate(this, LocaleSvSE, DateLong))
+	assert.Equal(t, "1:a mars "+this.Format("2006"), PresentDate(this, LocaleSvSE, DateLong|DateWithYear))
+	assert.Equal(t, "måndagen den 13:e december 2021", PresentDate(past, LocaleSvSE, DateFull))
+	assert.Equal(t, "måndag 13 december 2021", PresentDate(past, LocaleSvSE, DateMedium|DateWithWeekday))
+	assert.Equal(t, "den trettonde december tjugohundratjugoett", PresentDate(past, LocaleSvSE, DateLong|DateSpelledOut))
+	assert.Equal(t, "måndagen den trettonde december tjugohundratjugoett", PresentDate(past, LocaleSvSE, DateFull|DateSpelledOut))
+}
+
+func TestPresentDateEN(t *testing.T) {
+	past := time.Date(2021, time.December, 13, 0, 0, 0, 0, time.UTC)
+	this := time.Date(time.Now().Year(), time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "12/13/2021", PresentDate(past, LocaleEnUS, DateShort))
+	assert.Equal(t, "3/1", PresentDate(this, LocaleEnUS, DateShort))
+	assert.Equal(t, "December 13, 2021", PresentDate(past, LocaleEnUS, DateMedium))
+	assert.Equal(t, "December 13th, 2021", PresentDate(past, LocaleEnUS, DateLong))
+	assert.Equal(t, "March 1st", PresentDate(this, LocaleEnUS, DateLong))
+	assert.Equal(t, "Monday, December 13th, 2021", PresentDate(past, LocaleEnUS, DateFull))
+	assert.Equal(t, "Monday, December thirteenth, twenty twenty-one", PresentDate(past, LocaleEnUS, DateFull|DateSpelledOut))
+}
+
+func TestPresentYear(t *testing.T) {
+	expectedSV := map[int]string{
+		1998: "nittonhundranittioåtta",
+		1900: "nittonhundra",
+		2000: "tvåtusen",
+		2005: "tvåtusenfem",
+		2021: "tjugohundratjugoett",
+	}
+	for n, expect := range expectedSV {
+		assert.Equal(t, expect, presentYearSvSE(n))
+	}
+
+	expectedEN := map[int]string{
+		1998: "nineteen ninety-eight",
+		1900: "nineteen hundred",
+		1905: "nineteen oh five",
+		2000: "two thousand",
+		2021: "twenty twenty-one",
+	}
+	for n, expect := range expectedEN {
+		assert.Equal(t, expect, presentYearEnUS(n))
+	}
+}
+
+func TestPresentDateRangeSV(t *testing.T) {
+	// the reference time is 2025-04-17 15:30
+	now := time.Date(2025, time.April, 17, 15, 30, 0, 0, time.UTC)
+	year := now.Year()
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	assert.Equal(t, "3–5 maj 2024", presentDateRange(day(2024, 5, 3), day(2024, 5, 6), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "3–5 maj", presentDateRange(day(year, 5, 3), day(year, 5, 6), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "3–5 maj "+day(year, 1, 1).Format("2006"), presentDateRange(day(year, 5, 3), day(year, 5, 6), LocaleSvSE, DateMedium|DateWithYear, now))
+	assert.Equal(t, "30 april – 2 maj", presentDateRange(day(year, 4, 30), day(year, 5, 3), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "30 december 2024 – 2 januari 2025", presentDateRange(day(2024, 12, 30), day(2025, 1, 3), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "3:e–5:e maj 2024", presentDateRange(day(2024, 5, 3), day(2024, 5, 6), LocaleSvSE, DateLong, now))
+	assert.Equal(t, "fredag 3 – söndag 5 maj 2024", presentDateRange(day(2024, 5, 3), day(2024, 5, 6), LocaleSvSE, DateMedium|DateWithWeekday, now))
+	assert.Equal(t, "3–5/5", presentDateRange(day(year, 5, 3), day(year, 5, 6), LocaleSvSE, DateShort, now))
+	assert.Equal(t, "30/4–2/5", presentDateRange(day(year, 4, 30), day(year, 5, 3), LocaleSvSE, DateShort, now))
+	assert.Equal(t, "2024-05-03 – 2024-05-05", presentDateRange(day(2024, 5, 3), day(2024, 5, 6), LocaleSvSE, DateShort, now))
+	assert.Equal(t, "den tredje till den femte maj tjugohundratjugofyra", presentDateRange(day(2024, 5, 3), day(2024, 5, 6), LocaleSvSE, DateLong|DateSpelledOut, now))
+	assert.Equal(t, "3 maj 2024", presentDateRange(day(2024, 5, 3), day(2024, 5, 4), LocaleSvSE, DateMedium, now))
+
+	// time of day
+	today := beginningOfDay(now)
+	assert.Equal(t, "kl 9–17", presentDateRange(today.Add(9*time.Hour), today.Add(17*time.Hour), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "kl 9:30–11", presentDateRange(today.Add(9*time.Hour+30*time.Minute), today.Add(11*time.Hour), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "3 maj 2024 kl 9–17", presentDateRange(day(2024, 5, 3).Add(9*time.Hour), day(2024, 5, 3).Add(17*time.Hour), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "3 maj 2024 kl 22–24", presentDateRange(day(2024, 5, 3).Add(22*time.Hour), day(2024, 5, 4), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "3–4 maj 2024 kl 22–2", presentDateRange(day(2024, 5, 3).Add(22*time.Hour), day(2024, 5, 4).Add(2*time.Hour), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "30 april – 1 maj kl 22–2", presentDateRange(day(year, 4, 30).Add(22*time.Hour), day(year, 5, 1).Add(2*time.Hour), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "3 maj 2024 kl 22 – 5 maj kl 2", presentDateRange(day(2024, 5, 3).Add(22*time.Hour), day(2024, 5, 5).Add(2*time.Hour), LocaleSvSE, DateMedium, now))
+	assert.Equal(t, "31 december 2024 kl 22 – 2 januari 2025 kl 2", presentDateRange(day(2024, 12, 31).Add(22*time.Hour), day(2025, 1, 2).Add(2*time.Hour), LocaleSvSE, DateMedium, now))
+}
+
+func TestPresentDateRangeEN(t *testing.T) {
+	// the reference time is 2025-04-17 15:30
+	now := time.Date(2025, time.April, 17, 15, 30, 0, 0, time.UTC)
+	year := now.Year()
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	assert.Equal(t, "May 3–5", presentDateRange(day(year, 5, 3), day(year, 5, 6), LocaleEnUS, DateMedium, now))
+	assert.Equal(t, "May 3–5, 2024", presentDateRange(day(2024, 5, 3), day(2024, 5, 6), LocaleEnUS, DateMedium, now))
+	assert.Equal(t, "April 30 – May 2", presentDateRange(day(year, 4, 30), day(year, 5, 3), LocaleEnUS, DateMedium, now))
+	assert.Equal(t, "December 30, 2024 – January 2, 2025", presentDateRange(day(2024, 12, 30), day(2025, 1, 3), LocaleEnUS, DateMedium, now))
+	assert.Equal(t, "May 3rd–5th, 2024", presentDateRange(day(2024, 5, 3), day(2024, 5, 6), LocaleEnUS, DateLong, now))
+	assert.Equal(t, "Friday, May 3 – Sunday, May 5, 2024", presentDateRange(day(2024, 5, 3), day(2024, 5, 6), LocaleEnUS, DateMedium|DateWithWeekday, now))
+	assert.Equal(t, "5/3–5/5", presentDateRange(day(year, 5, 3), day(year, 5, 6), LocaleEnUS, DateShort, now))
+	assert.Equal(t, "May third to fifth, twenty twenty-four", presentDateRange(day(2024, 5, 3), day(2024, 5, 6), LocaleEnUS, DateLong|DateSpelledOut, now))
+
+	today := beginningOfDay(now)
+	assert.Equal(t, "9 AM–5 PM", presentDateRange(today.Add(9*time.Hour), today.Add(17*time.Hour), LocaleEnUS, DateMedium, now))
+	assert.Equal(t, "May 3, 2024, 9 AM–5 PM", presentDateRange(day(2024, 5, 3).Add(9*time.Hour), day(2024, 5, 3).Add(17*time.Hour), LocaleEnUS, DateMedium, now))
+	assert.Equal(t, "May 3–4, 2024, 10 PM–2 AM", presentDateRange(day(2024, 5, 3).Add(22*time.Hour), day(2024, 5, 4).Add(2*time.Hour), LocaleEnUS, DateMedium, now))
+	assert.Equal(t, "May 3, 2024, 10 PM – May 5, 2 AM", presentDateRange(day(2024, 5, 3).Add(22*time.Hour), day(2024, 5, 5).Add(2*time.Hour), LocaleEnUS, DateMedium, now))
+}
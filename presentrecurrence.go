@@ -0,0 +1,519 @@
+package natural
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PresentRecurrence returns r in words, such as "varje vardag kl 09:00",
+// "den tredje tisdagen varje månad" or "every weekday at 9 AM"
+func PresentRecurrence(r Recurrence, locale string) string {
+	switch locale {
+	case LocaleSvSE:
+		return presentRecurrenceSvSE(r)
+	}
+	return presentRecurrenceEnUS(r)
+}
+
+// PresentRRule returns an RFC 5545 RRULE in words, see PresentRecurrence
+func PresentRRule(s string, locale string) (string, error) {
+	r, err := ParseRRule(s)
+	if err != nil {
+		return "", err
+	}
+	return PresentRecurrence(r, locale), nil
+}
+
+// PresentCron returns a 5-field cron expression in words, "0 9 * * 1-5" is
+// "varje vardag kl 09:00", see PresentRecurrence
+func PresentCron(s string, locale string) (string, error) {
+	r, err := ParseCron(s)
+	if err != nil {
+		return "", err
+	}
+	return PresentRecurrence(r, locale), nil
+}
+
+func presentRecurrenceSvSE(r Recurrence) string {
+	s := ""
+	days := plainWeekdays(r.ByDay)
+	names := []string{}
+	for _, wd := range days {
+		names = append(names, weekdayName(wd, LocaleSvSE, false, false))
+	}
+	months := []string{}
+	for _, m := range r.ByMonth {
+		months = append(months, monthName(m, LocaleSvSE, false, false))
+	}
+
+	freq := r.Freq
+	if len(days) > 0 && r.Interval <= 1 && freq > FreqHourly && monthSelectionSvSE(r) == "" {
+		// every monday of every month is every monday
+		freq = FreqWeekly
+	}
+	switch freq {
+	case FreqMinutely:
+		s = everySvSE(r.Interval, "minut", false)
+	case FreqHourly:
+		switch hourFraction(r) {
+		case 30:
+			s = "varje halvtimme"
+		case 15:
+			s = "varje kvart"
+		default:
+			s = everySvSE(r.Interval, "timme", false)
+		}
+	case FreqDaily:
+		s = everySvSE(r.Interval, "dag", false)
+	case FreqWeekly:
+		switch {
+		case len(days) == 0:
+			s = everySvSE(r.Interval, "vecka", false)
+		case r.Interval <= 1 && isWorkWeek(days):
+			s = "varje vardag"
+		case r.Interval <= 1 || len(days) == 1:
+			s = everySvSE(r.Interval, PresentListSvSE(names), false)
+		default:
+			s = everySvSE(r.Interval, "vecka", false) + " på " + PresentListSvSE(names)
+		}
+	case FreqMonthly:
+		s = everySvSE(r.Interval, "månad", false)
+		if sel := monthSelectionSvSE(r); sel != "" {
+			s = sel + " " + s
+		}
+	case FreqYearly:
+		s = everySvSE(r.Interval, "år", true)
+		sel := monthSelectionSvSE(r)
+		switch {
+		case sel != "" && len(r.ByMonthDay) > 0 && len(months) > 0:
+			// "den 24:e december varje år"
+			s = sel + " " + PresentListSvSE(months) + " " + s
+		case sel != "" && len(months) > 0:
+			s = sel + " i " + PresentListSvSE(months) + " " + s
+		case len(months) > 0:
+			s += " i " + PresentListSvSE(months)
+		}
+		months = nil
+	}
+	if freq != FreqWeekly && len(days) > 0 {
+		// "varannan dag på måndagar"
+		s += " på " + PresentListSvSE(withSuffix(names, "ar"))
+	}
+	if len(months) > 0 {
+		s += " i " + PresentListSvSE(months)
+	}
+
+	switch r.Freq {
+	case FreqMinutely:
+		if len(r.ByHour) > 0 {
+			if from, to, ok := hourRange(r.ByHour); ok {
+				s += fmt.Sprintf(" mellan kl %02d:00 och %02d:00", from, to+1)
+			} else {
+				s += " under timmarna " + PresentListSvSE(intStrings(r.ByHour, "%d"))
+			}
+		}
+	case FreqHourly:
+		minutes := withoutZero(r.ByMinute)
+		if hourFraction(r) != 0 {
+			minutes = nil
+		}
+		_, _, ok := hourRange(r.ByHour)
+		if ok && len(minutes) == 1 {
+			// "mellan kl 09:30 och 17:30" tells the minute
+			minutes = nil
+		}
+		if len(minutes) > 0 {
+			s += " kl " + PresentListSvSE(intStrings(minutes, "xx:%02d"))
+		}
+		if ok {
+			clocks := recurrenceClocks(r)
+			first, last := clocks[0], clocks[len(clocks)-1]
+			s += fmt.Sprintf(" mellan kl %02d:%02d och %02d:%02d", first/60, first%60, last/60, last%60)
+		} else if len(r.ByHour) > 0 {
+			s += " under timmarna " + PresentListSvSE(intStrings(r.ByHour, "%d"))
+		}
+	default:
+		if clocks := recurrenceClocks(r); len(clocks) > 0 {
+			list := []string{}
+			for _, c := range clocks {
+				list = append(list, fmt.Sprintf("%02d:%02d", c/60, c%60))
+			}
+			s += " kl " + PresentListSvSE(list)
+		}
+	}
+
+	if r.Count > 0 {
+		s += fmt.Sprintf(", %d gånger", r.Count)
+	}
+	if !r.Until.IsZero() {
+		s += " till och med " + PresentDate(r.Until, LocaleSvSE, DateMedium|DateWithYear)
+	}
+	return s
+}
+
+func presentRecurrenceEnUS(r Recurrence) string {
+	s := ""
+	days := plainWeekdays(r.ByDay)
+	names := []string{}
+	for _, wd := range days {
+		names = append(names, weekdayName(wd, LocaleEnUS, false, false))
+	}
+	months := []string{}
+	for _, m := range r.ByMonth {
+		months = append(months, monthName(m, LocaleEnUS, false, false))
+	}
+
+	freq := r.Freq
+	if len(days) > 0 && r.Interval <= 1 && freq > FreqHourly && monthSelectionEnUS(r) == "" {
+		// every monday of every month is every monday
+		freq = FreqWeekly
+	}
+	switch freq {
+	case FreqMinutely:
+		s = everyEnUS(r.Interval, "minute", "minutes")
+	case FreqHourly:
+		if n := hourFraction(r); n != 0 {
+			s = fmt.Sprintf("every %d minutes", n)
+		} else {
+			s = everyEnUS(r.Interval, "hour", "hours")
+		}
+	case FreqDaily:
+		s = everyEnUS(r.Interval, "day", "")
+	case FreqWeekly:
+		switch {
+		case len(days) == 0:
+			s = everyEnUS(r.Interval, "week", "")
+		case r.Interval <= 1 && isWorkWeek(days):
+			s = "every weekday"
+		case r.Interval <= 1 || len(days) == 1:
+			s = everyEnUS(r.Interval, PresentListEnUS(names), "")
+		default:
+			s = everyEnUS(r.Interval, "week", "") + " on " + PresentListEnUS(names)
+		}
+	case FreqMonthly:
+		s = everyEnUS(r.Interval, "month", "")
+		if sel := monthSelectionEnUS(r); sel != "" {
+			s = sel + " of " + s
+		}
+	case FreqYearly:
+		s = everyEnUS(r.Interval, "year", "")
+		sel := monthSelectionEnUS(r)
+		switch {
+		case sel != "" && len(r.ByMonthDay) > 0 && len(months) > 0:
+			// "every year on December 24th"
+			s += " on " + PresentListEnUS(months) + " " + strings.TrimPrefix(sel, "the ")
+		case sel != "" && len(months) > 0:
+			s += " on " + sel + " of " + PresentListEnUS(months)
+		case len(months) > 0:
+			s += " in " + PresentListEnUS(months)
+		}
+		months = nil
+	}
+	if freq != FreqWeekly && len(days) > 0 {
+		// "every other day on Mondays"
+		s += " on " + PresentListEnUS(withSuffix(names, "s"))
+	}
+	if len(months) > 0 {
+		s += " in " + PresentListEnUS(months)
+	}
+
+	switch r.Freq {
+	case FreqMinutely:
+		if len(r.ByHour) > 0 {
+			if from, to, ok := hourRange(r.ByHour); ok {
+				s += " between " + clockEnUSDigits(from*60) + " and " + clockEnUSDigits((to+1)%24*60)
+			} else {
+				s += " during the hours " + PresentListEnUS(intStrings(r.ByHour, "%d"))
+			}
+		}
+	case FreqHourly:
+		minutes := withoutZero(r.ByMinute)
+		if hourFraction(r) != 0 {
+			minutes = nil
+		}
+		_, _, ok := hourRange(r.ByHour)
+		if ok && len(minutes) == 1 {
+			// "from 9:30 AM to 5:30 PM" tells the minute
+			minutes = nil
+		}
+		if len(minutes) > 0 {
+			s += " at " + PresentListEnUS(intStrings(minutes, ":%02d"))
+		}
+		if ok {
+			clocks := recurrenceClocks(r)
+			s += " from " + clockEnUSDigits(clocks[0]) + " to " + clockEnUSDigits(clocks[len(clocks)-1])
+		} else if len(r.ByHour) > 0 {
+			s += " during the hours " + PresentListEnUS(intStrings(r.ByHour, "%d"))
+		}
+	default:
+		if clocks := recurrenceClocks(r); len(clocks) > 0 {
+			list := []string{}
+			for _, c := range clocks {
+				list = append(list, clockEnUSDigits(c))
+			}
+			s += " at " + PresentListEnUS(list)
+		}
+	}
+
+	if r.Count > 0 {
+		s += fmt.Sprintf(", %d times", r.Count)
+	}
+	if !r.Until.IsZero() {
+		s += " until " + PresentDate(r.Until, LocaleEnUS, DateMedium|DateWithYear)
+	}
+	return s
+}
+
+// everySvSE returns "varje dag", "varannan dag" or "var tredje dag",
+// or "vartannat år" and "vart tredje år" for neuter units
+func everySvSE(n int, unit string, neuter bool) string {
+	switch {
+	case n <= 1:
+		return "varje " + unit
+	case n == 2 && neuter:
+		return "vartannat " + unit
+	case n == 2:
+		return "varannan " + unit
+	case neuter:
+		return "vart " + countSvSE(n) + " " + unit
+	}
+	return "var " + countSvSE(n) + " " + unit
+}
+
+// everyEnUS returns "every day", "every other day" or "every third day". Units
+// with a plural are counted, "every 15 minutes"
+func everyEnUS(n int, unit, plural string) string {
+	switch {
+	case n <= 1:
+		return "every " + unit
+	case n == 2:
+		return "every other " + unit
+	case plural != "":
+		return fmt.Sprintf("every %d %s", n, plural)
+	}
+	return "every " + countEnUS(n) + " " + unit
+}
+
+// monthSelectionSvSE returns "den 15:e", "den sista dagen" or "den tredje tisdagen"
+func monthSelectionSvSE(r Recurrence) string {
+	list := []string{}
+	for _, d := range r.ByMonthDay {
+		if d < 0 {
+			list = append(list, ordinalSvSE(d)+" dagen")
+		} else {
+			list = append(list, PresentCountShortSwedish(d))
+		}
+	}
+	for _, d := range r.ByDay {
+		if d.N != 0 {
+			list = append(list, ordinalSvSE(d.N)+" "+weekdayName(d.Weekday, LocaleSvSE, false, false)+"en")
+		}
+	}
+	if len(list) == 0 {
+		return ""
+	}
+	return "den " + PresentListSvSE(list)
+}
+
+// monthSelectionEnUS returns "the 15th", "the last day" or "the third Tuesday"
+func monthSelectionEnUS(r Recurrence) string {
+	list := []string{}
+	for _, d := range r.ByMonthDay {
+		if d < 0 {
+			list = append(list, ordinalEnUS(d)+" day")
+		} else {
+			list = append(list, strings.Replace(PresentCountShortEnglish(d), ":", "", 1))
+		}
+	}
+	for _, d := range r.ByDay {
+		if d.N != 0 {
+			list = append(list, ordinalEnUS(d.N)+" "+weekdayName(d.Weekday, LocaleEnUS, false, false))
+		}
+	}
+	if len(list) == 0 {
+		return ""
+	}
+	return "the " + PresentListEnUS(list)
+}
+
+// ordinalSvSE returns "tredje" for 3, "sista" for -1 and "näst sista" for -2
+func ordinalSvSE(n int) string {
+	switch {
+	case n == -1:
+		return "sista"
+	case n == -2:
+		return "näst sista"
+	case n < 0:
+		return countSvSE(-n) + " från slutet"
+	}
+	return countSvSE(n)
+}
+
+// ordinalEnUS returns "third" for 3, "last" for -1 and "second to last" for -2
+func ordinalEnUS(n int) string {
+	switch {
+	case n == -1:
+		return "last"
+	case n < 0:
+		return countEnUS(-n) + " to last"
+	}
+	return countEnUS(n)
+}
+
+// countSvSE returns "tredje" for 3, and digits such as "100:e" above 99
+func countSvSE(n int) string {
+	if n < 100 {
+		return PresentCountSwedish(n)
+	}
+	if (n%10 == 1 || n%10 == 2) && n%100 != 11 && n%100 != 12 {
+		return fmt.Sprintf("%d:a", n)
+	}
+	return fmt.Sprintf("%d:e", n)
+}
+
+// countEnUS returns "third" for 3 and "thirtieth" for 30, and digits such as
+// "21st" or "100th" where PresentCountEnglish has no word
+func countEnUS(n int) string {
+	if n <= 20 || (n < 100 && n%10 == 0) {
+		return PresentCountEnglish(n)
+	}
+	return ordinalDigitsEnUS(n)
+}
+
+// ordinalDigitsEnUS returns "1st", "22nd" or "100th"
+func ordinalDigitsEnUS(n int) string {
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		return fmt.Sprintf("%dth", n)
+	case n%10 == 1:
+		return fmt.Sprintf("%dst", n)
+	case n%10 == 2:
+		return fmt.Sprintf("%dnd", n)
+	case n%10 == 3:
+		return fmt.Sprintf("%drd", n)
+	}
+	return fmt.Sprintf("%dth", n)
+}
+
+// clockEnUSDigits returns minutes after midnight as "9 AM" or "5:30 PM"
+func clockEnUSDigits(c int) string {
+	hour, minute := c/60, c%60
+	s := fmt.Sprintf("%d", hour12(hour))
+	if minute != 0 {
+		s += fmt.Sprintf(":%02d", minute)
+	}
+	if hour < 12 {
+		return s + " AM"
+	}
+	return s + " PM"
+}
+
+// recurrenceClocks returns the times of day of r as minutes after midnight, in order
+func recurrenceClocks(r Recurrence) []int {
+	var res []int
+	if len(r.ByHour) == 0 {
+		return res
+	}
+	minutes := r.ByMinute
+	if len(minutes) == 0 {
+		minutes = []int{0}
+	}
+	for _, h := range r.ByHour {
+		for _, m := range minutes {
+			res = append(res, h*60+m)
+		}
+	}
+	sort.Ints(res)
+	return res
+}
+
+// plainWeekdays returns the weekdays of days without a position in the month
+func plainWeekdays(days []NthWeekday) []time.Weekday {
+	var res []time.Weekday
+	for _, d := range days {
+		if d.N == 0 {
+			res = append(res, d.Weekday)
+		}
+	}
+	return res
+}
+
+// isWorkWeek returns true if days are monday to friday
+func isWorkWeek(days []time.Weekday) bool {
+	if len(days) != len(workWeek) {
+		return false
+	}
+	for _, wd := range days {
+		if wd == time.Saturday || wd == time.Sunday {
+			return false
+		}
+	}
+	seen := map[time.Weekday]bool{}
+	for _, wd := range days {
+		seen[wd] = true
+	}
+	return len(seen) == len(workWeek)
+}
+
+// hourRange returns the first and last of consecutive hours
+func hourRange(hours []int) (int, int, bool) {
+	if len(hours) == 0 {
+		return 0, 0, false
+	}
+	sorted := append([]int{}, hours...)
+	sort.Ints(sorted)
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i] != sorted[i-1]+1 {
+			return 0, 0, false
+		}
+	}
+	return sorted[0], sorted[len(sorted)-1], true
+}
+
+// hourFraction returns 30 or 15 for a rule every half or quarter hour, otherwise 0
+func hourFraction(r Recurrence) int {
+	if r.Freq != FreqHourly || r.Interval > 1 {
+		return 0
+	}
+	minutes := append([]int{}, r.ByMinute...)
+	sort.Ints(minutes)
+	for _, step := range []int{30, 15} {
+		if len(minutes) != 60/step {
+			continue
+		}
+		match := true
+		for i, m := range minutes {
+			match = match && m == i*step
+		}
+		if match {
+			return step
+		}
+	}
+	return 0
+}
+
+// withSuffix returns list with suffix added to each string, "måndag" => "måndagar"
+func withSuffix(list []string, suffix string) []string {
+	res := []string{}
+	for _, s := range list {
+		res = append(res, s+suffix)
+	}
+	return res
+}
+
+func withoutZero(list []int) []int {
+	if len(list) == 1 && list[0] == 0 {
+		return nil
+	}
+	return list
+}
+
+func intStrings(list []int, format string) []string {
+	res := []string{}
+	for _, i := range list {
+		res = append(res, fmt.Sprintf(format, i))
+	}
+	return res
+}
@@ -0,0 +1,102 @@
+package natural
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresentRRule(t *testing.T) {
+	expectedSV := map[string]string{
+		"FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0":     "varje vardag kl 09:00",
+		"FREQ=WEEKLY;BYDAY=MO;BYHOUR=9;BYMINUTE=30":                "varje måndag kl 09:30",
+		"FREQ=WEEKLY;BYDAY=MO,WE,FR":                               "varje måndag, onsdag och fredag",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=FR":                          "varannan fredag",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH":                       "varannan vecka på tisdag och torsdag",
+		"FREQ=DAILY;INTERVAL=3":                                    "var tredje dag",
+		"FREQ=MINUTELY;INTERVAL=15":                                "var femtonde minut",
+		"FREQ=MONTHLY;BYDAY=3TU":                                   "den tredje tisdagen varje månad",
+		"FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=15":                        "den sista fredagen varje månad kl 15:00",
+		"FREQ=MONTHLY;BYMONTHDAY=15;INTERVAL=2":                    "den 15:e varannan månad",
+		"FREQ=MONTHLY;BYMONTHDAY=-1":                               "den sista dagen varje månad",
+		"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24":                     "den 24:e december varje år",
+		"FREQ=YEARLY;INTERVAL=2":                                   "vartannat år",
+		"FREQ=DAILY;BYHOUR=8,17;COUNT=10":                          "varje dag kl 08:00 och 17:00, 10 gånger",
+		"FREQ=WEEKLY;UNTIL=20240630T235959":                        "varje vecka till och med 30 juni 2024",
+		"FREQ=DAILY;INTERVAL=21":                                   "var tjugoförsta dag",
+		"FREQ=DAILY;INTERVAL=100":                                  "var 100:e dag",
+		"FREQ=HOURLY;BYMINUTE=45":                                  "varje timme kl xx:45",
+		"FREQ=HOURLY;BYMINUTE=0,30":                                "varje halvtimme",
+		"FREQ=HOURLY;BYMINUTE=45,0,15,30":                          "varje kvart",
+		"FREQ=HOURLY;BYHOUR=9,10,11,12,13,14,15,16,17;BYMINUTE=30": "varje timme mellan kl 09:30 och 17:30",
+		"FREQ=MONTHLY;BYDAY=MO":                                    "varje måndag",
+		"FREQ=DAILY;BYDAY=MO,TU":                                   "varje måndag och tisdag",
+		"FREQ=YEARLY;BYMONTH=7;BYDAY=SA":                           "varje lördag i juli",
+		"FREQ=DAILY;INTERVAL=2;BYDAY=MO,TU":                        "varannan dag på måndagar och tisdagar",
+		"FREQ=HOURLY;BYDAY=MO":                                     "varje timme på måndagar",
+	}
+	for in, expect := range expectedSV {
+		s, err := PresentRRule(in, LocaleSvSE)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, s, in)
+	}
+
+	expectedEN := map[string]string{
+		"FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=0":     "every weekday at 9 AM",
+		"FREQ=WEEKLY;BYDAY=MO;BYHOUR=17;BYMINUTE=30":               "every Monday at 5:30 PM",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH":                       "every other week on Tuesday and Thursday",
+		"FREQ=DAILY;INTERVAL=3":                                    "every third day",
+		"FREQ=MINUTELY;INTERVAL=15":                                "every 15 minutes",
+		"FREQ=MONTHLY;BYDAY=3TU":                                   "the third Tuesday of every month",
+		"FREQ=MONTHLY;BYMONTHDAY=15":                               "the 15th of every month",
+		"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24":                     "every year on December 24th",
+		"FREQ=DAILY;COUNT=5":                                       "every day, 5 times",
+		"FREQ=WEEKLY;UNTIL=20240630T235959":                        "every week until June 30, 2024",
+		"FREQ=DAILY;INTERVAL=21":                                   "every 21st day",
+		"FREQ=DAILY;INTERVAL=100":                                  "every 100th day",
+		"FREQ=HOURLY;BYMINUTE=0,30":                                "every 30 minutes",
+		"FREQ=HOURLY;BYHOUR=9,10,11,12,13,14,15,16,17;BYMINUTE=30": "every hour from 9:30 AM to 5:30 PM",
+		"FREQ=MONTHLY;BYDAY=MO":                                    "every Monday",
+		"FREQ=DAILY;BYDAY=MO,TU":                                   "every Monday and Tuesday",
+		"FREQ=DAILY;INTERVAL=2;BYDAY=MO,TU":                        "every other day on Mondays and Tuesdays",
+	}
+	for in, expect := range expectedEN {
+		s, err := PresentRRule(in, LocaleEnUS)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, s, in)
+	}
+}
+
+func TestPresentCron(t *testing.T) {
+	expectedSV := map[string]string{
+		"0 9 * * 1-5":    "varje vardag kl 09:00",
+		"0 3 * * *":      "varje dag kl 03:00",
+		"*/15 * * * *":   "var femtonde minut",
+		"*/5 9-16 * * *": "var femte minut mellan kl 09:00 och 17:00",
+		"0 * * * *":      "varje timme",
+		"15 */2 * * *":   "varannan timme kl xx:15",
+		"0 9 * 1 1":      "varje måndag i januari kl 09:00",
+		"0 6 1 * *":      "den 1:a varje månad kl 06:00",
+		"0 12 * 7 *":     "varje dag i juli kl 12:00",
+	}
+	for in, expect := range expectedSV {
+		s, err := PresentCron(in, LocaleSvSE)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, s, in)
+	}
+
+	expectedEN := map[string]string{
+		"0 9 * * 1-5":    "every weekday at 9 AM",
+		"0 0 * * 0,6":    "every Sunday and Saturday at 12 AM",
+		"*/5 9-16 * * *": "every 5 minutes between 9 AM and 5 PM",
+		"15 */2 * * *":   "every other hour at :15",
+	}
+	for in, expect := range expectedEN {
+		s, err := PresentCron(in, LocaleEnUS)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, s, in)
+	}
+
+	_, err := PresentCron("0 9 1 * 1", LocaleSvSE)
+	assert.NotEqual(t, nil, err)
+}
@@ -0,0 +1,220 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	// range words, tried in order. Prefixes pick the separators that may follow
+	rangePrefixes = []struct {
+		prefix     string
+		separators []string
+	}{
+		{"mellan ", []string{" och "}},
+		{"between ", []string{" and "}},
+		{"från och med ", []string{" till och med ", " till ", " tills ", " – ", " - ", "–", "-"}},
+		{"från ", []string{" till och med ", " till ", " tills ", " – ", " - ", "–", "-"}},
+		{"from ", []string{" to ", " until ", " till ", " through ", " thru ", " – ", " - ", "–", "-"}},
+		{"", []string{" till och med ", " till ", " tills ", " to ", " until ", " through ", " thru ", " – ", " - ", "–", "-"}},
+	}
+
+	// "kl 9", "9:30", "9 am", "at 17"
+	rangeClockRegex = regexp.MustCompile(`(?:kl\.?|klockan|at) \d|\d[:.]\d\d|\d ?(?:am|pm)$`)
+
+	// "9", "kl 9", "17:30", "5pm", "3:e", "3rd"
+	rangeBareRegex = regexp.MustCompile(`^(?:kl\.? |klockan |at )?(\d{1,2}(?:[:.]\d{2})?(?: ?(?:am|pm))?|\d{1,2}:?(?:e|a|st|nd|rd|th))$`)
+
+	// "imorgon 9", "fredag kl 17:30"
+	rangeDayClockRegex = regexp.MustCompile(`^(.+?),? (?:kl\.? |klockan |at )?(\d{1,2}(?:[:.]\d{2})?(?: ?(?:am|pm))?)$`)
+
+	// ", 2024", " 2024"
+	rangeYearRegex = regexp.MustCompile(`^(.+?),? (\d{4})$`)
+)
+
+// rangeEndpoint is one side of a range, either a point in time or a whole period
+type rangeEndpoint struct {
+	Interval
+	clock   bool
+	weekday bool
+}
+
+// ParseRange parses a range relative to the current time, see Parser.ParseRange
+func ParseRange(s string) (Interval, error) {
+	return NewParser(LocaleSvSE).ParseRange(s)
+}
+
+// ParseRange parses ranges like "från 3 maj till 5 maj", "mellan 3 och 5 maj", "3–5 maj",
+// "30 april – 2 maj 2024", "9-17", "från kl 9 till 17", "mån-fre", "from Monday to Friday",
+// "May 3-5" or "march through may". Parts missing from one end, such as month and year,
+// are taken from the other. Days and periods are included in full, so "3–5 maj" ends
+// at midnight after May 5th, while clock times end at the time given
+func (p *Parser) ParseRange(s string) (Interval, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	var firstErr error
+	for _, rp := range rangePrefixes {
+		if !strings.HasPrefix(s, rp.prefix) {
+			continue
+		}
+		rest := s[len(rp.prefix):]
+		for _, sep := range rp.separators {
+			for i := 0; ; {
+				j := strings.Index(rest[i:], sep)
+				if j == -1 {
+					break
+				}
+				j += i
+				left, right := strings.TrimSpace(rest[:j]), strings.TrimSpace(rest[j+len(sep):])
+				if left != "" && right != "" {
+					res, err := p.rangeFromParts(left, right)
+					if err == nil {
+						return res, nil
+					}
+					if firstErr == nil {
+						firstErr = err
+					}
+				}
+				i = j + len(sep)
+			}
+		}
+	}
+	if firstErr != nil {
+		return Interval{}, fmt.Errorf("Cannot parse range: %s: %s", s, firstErr)
+	}
+	return Interval{}, fmt.Errorf("Cannot parse range: %s", s)
+}
+
+// rangeFromParts parses the two sides of a range, letting each inherit missing parts
+func (p *Parser) rangeFromParts(left, right string) (Interval, error) {
+	// "30 april – 2 maj 2024": the year is shared
+	leftYear, rightYear := "", ""
+	if match := rangeYearRegex.FindStringSubmatch(left); match != nil {
+		left, leftYear = match[1], match[2]
+	}
+	if match := rangeYearRegex.FindStringSubmatch(right); match != nil {
+		right, rightYear = match[1], match[2]
+	}
+
+	leftBare, rightBare := rangeBareRegex.MatchString(left), rangeBareRegex.MatchString(right)
+	switch {
+	case leftBare && !rightBare:
+		// "3–5 maj" => "3 maj", "5 maj"
+		left = inheritRangeNumber(right, left)
+	case rightBare && !leftBare:
+		// "May 3-5" => "may 3", "may 5"
+		right = inheritRangeNumber(left, right)
+	}
+
+	inherited := false
+	if leftYear == "" && rightYear != "" {
+		leftYear, inherited = rightYear, true
+	}
+	if rightYear == "" {
+		rightYear = leftYear
+	}
+	if leftYear != "" {
+		left += " " + leftYear
+		right += " " + rightYear
+	}
+
+	a, err := p.parseRangeEndpoint(left)
+	if err != nil {
+		return Interval{}, err
+	}
+	b, err := p.parseRangeEndpoint(right)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	end := b.End
+	if b.clock {
+		end = b.Start
+	}
+	if !end.After(a.Start) {
+		switch {
+		case b.clock && a.clock:
+			// "22-02"
+			end = end.AddDate(0, 0, 1)
+		case b.weekday:
+			// "fre-mån"
+			end = end.AddDate(0, 0, 7)
+		case inherited:
+			// "30 december – 2 januari 2025"
+			a.Start = a.Start.AddDate(-1, 0, 0)
+		default:
+			return Interval{}, fmt.Errorf("Range ends before it starts: %s - %s", left, right)
+		}
+	}
+	return Interval{Start: a.Start, End: end}, nil
+}
+
+// inheritRangeNumber replaces the day, or the time of day, in full with bare
+func inheritRangeNumber(full, bare string) string {
+	tokens := strings.Split(full, " ")
+	clock := rangeClockRegex.MatchString(full)
+	idx := -1
+	for i, tok := range tokens {
+		if !rangeBareRegex.MatchString(strings.TrimSuffix(tok, ",")) {
+			continue
+		}
+		if idx == -1 || clock {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return bare
+	}
+	bare = strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(bare, "klockan "), "kl "), "at ")
+	if strings.HasSuffix(tokens[idx], ",") {
+		bare += ","
+	}
+	tokens[idx] = bare
+	return strings.Join(tokens, " ")
+}
+
+// parseRangeEndpoint parses a time of day, a day or a period
+func (p *Parser) parseRangeEndpoint(s string) (rangeEndpoint, error) {
+	now := p.now()
+
+	if wd, err := parseWeekdayPlural(s); err == nil {
+		day := addDay(now, (int(wd)-int(now.Weekday())+7)%7)
+		return rangeEndpoint{Interval: dayInterval(day, 1), weekday: true}, nil
+	}
+
+	if rangeBareRegex.MatchString(s) {
+		t, err := p.ParseTime(s)
+		if err != nil {
+			return rangeEndpoint{}, err
+		}
+		t = time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+		return rangeEndpoint{Interval: Interval{Start: t, End: t}, clock: true}, nil
+	}
+
+	if i, err := p.ParsePeriod(s); err == nil {
+		return rangeEndpoint{Interval: i}, nil
+	}
+
+	if t, err := p.ParseTime(s); err == nil {
+		// "kl 17", "halv fem", "six in the evening"
+		if rangeClockRegex.MatchString(s) || !isMidnight(t) {
+			return rangeEndpoint{Interval: Interval{Start: t, End: t}, clock: true}, nil
+		}
+		return rangeEndpoint{Interval: dayInterval(t, 1)}, nil
+	}
+
+	// "imorgon 9", "fredag kl 17"
+	if match := rangeDayClockRegex.FindStringSubmatch(s); match != nil {
+		day, err := p.parseRangeEndpoint(match[1])
+		if err == nil && !day.clock {
+			clock, err := p.parseRangeEndpoint(match[2])
+			if err != nil {
+				return rangeEndpoint{}, err
+			}
+			t := time.Date(day.Start.Year(), day.Start.Month(), day.Start.Day(), clock.Start.Hour(), clock.Start.Minute(), 0, 0, day.Start.Location())
+			return rangeEndpoint{Interval: Interval{Start: t, End: t}, clock: true}, nil
+		}
+	}
+	return rangeEndpoint{}, fmt.Errorf("Cannot parse range endpoint: %s", s)
+}
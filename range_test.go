@@ -0,0 +1,48 @@
+package natural
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRange(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	expected := map[string]string{
+		// swe
+		"3–5 maj 2024":                               "2024-05-03 00:00 - 2024-05-06 00:00",
+		"mellan 3 och 5 maj 2024":                    "2024-05-03 00:00 - 2024-05-06 00:00",
+		"från 3 maj till 5 maj 2024":                 "2024-05-03 00:00 - 2024-05-06 00:00",
+		"från och med 3:e till och med 5:e maj 2024": "2024-05-03 00:00 - 2024-05-06 00:00",
+		"30 april – 2 maj 2024":                      "2024-04-30 00:00 - 2024-05-03 00:00",
+		"30 december – 2 januari 2025":               "2024-12-30 00:00 - 2025-01-03 00:00",
+		"9-17":                                       "2024-04-17 09:00 - 2024-04-17 17:00",
+		"från kl 9 till 17":                          "2024-04-17 09:00 - 2024-04-17 17:00",
+		"mellan kl 9:30 och 11":                      "2024-04-17 09:30 - 2024-04-17 11:00",
+		"22-02":                                      "2024-04-17 22:00 - 2024-04-18 02:00",
+		"3 maj 2024 kl 9-17":                         "2024-05-03 09:00 - 2024-05-03 17:00",
+		"från måndag till fredag":                    "2024-04-22 00:00 - 2024-04-27 00:00",
+		"mån-fre":                                    "2024-04-22 00:00 - 2024-04-27 00:00",
+		"fredag-måndag":                              "2024-04-19 00:00 - 2024-04-23 00:00",
+		"mars till maj":                              "2024-03-01 00:00 - 2024-06-01 00:00",
+		"vecka 12-14":                                "2024-03-18 00:00 - 2024-04-08 00:00",
+		"2024-05-03 – 2024-05-05":                    "2024-05-03 00:00 - 2024-05-06 00:00",
+		// eng
+		"may 3-5, 2024":             "2024-05-03 00:00 - 2024-05-06 00:00",
+		"from may 3 to may 5, 2024": "2024-05-03 00:00 - 2024-05-06 00:00",
+		"between 9am and 5pm":       "2024-04-17 09:00 - 2024-04-17 17:00",
+		"from monday to friday":     "2024-04-22 00:00 - 2024-04-27 00:00",
+		"march through may":         "2024-03-01 00:00 - 2024-06-01 00:00",
+		"april 30 – may 2, 2024":    "2024-04-30 00:00 - 2024-05-03 00:00",
+	}
+	for in, expect := range expected {
+		i, err := p.ParseRange(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, i.String(), in)
+	}
+
+	for _, in := range []string{"ibland", "5 maj 2024 - 3 maj 2024", "från blå till grön"} {
+		_, err := p.ParseRange(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
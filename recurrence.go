@@ -0,0 +1,766 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the base unit of a Recurrence, as in RFC 5545
+type Frequency int
+
+// Frequencies, as FREQ in RFC 5545
+const (
+	FreqMinutely Frequency = iota
+	FreqHourly
+	FreqDaily
+	FreqWeekly
+	FreqMonthly
+	FreqYearly
+)
+
+var frequencyNames = []string{"MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+func (f Frequency) String() string {
+	return frequencyNames[f]
+}
+
+// NthWeekday is a weekday in a month, such as the first Tuesday (1) or the last
+// Friday (-1). N is 0 for every such weekday
+type NthWeekday struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Recurrence is a repeating schedule, a subset of an RFC 5545 RRULE
+type Recurrence struct {
+	Freq Frequency
+	// Interval is the number of units between occurrences, 2 for "varannan"
+	Interval   int
+	ByDay      []NthWeekday
+	ByMonthDay []int
+	ByMonth    []time.Month
+	ByHour     []int
+	ByMinute   []int
+	// Count limits the number of occurrences, 0 for no limit
+	Count int
+	// Until is the last possible occurrence, zero for no limit
+	Until time.Time
+	// Start is the first possible occurrence (DTSTART)
+	Start time.Time
+}
+
+var (
+	// RFC 5545 weekday codes, indexed by time.Weekday
+	rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+	workWeek = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	recurrenceUnits = map[string]Frequency{
+		// swe
+		"minut": FreqMinutely, "minuter": FreqMinutely,
+		"timme": FreqHourly, "timmar": FreqHourly,
+		"dag": FreqDaily, "dagar": FreqDaily, "natt": FreqDaily, "morgon": FreqDaily, "kväll": FreqDaily,
+		"vecka": FreqWeekly, "veckor": FreqWeekly,
+		"månad": FreqMonthly, "månader": FreqMonthly,
+		"år": FreqYearly,
+		// eng
+		"minute": FreqMinutely, "minutes": FreqMinutely,
+		"hour": FreqHourly, "hours": FreqHourly,
+		"day": FreqDaily, "days": FreqDaily, "night": FreqDaily, "morning": FreqDaily, "evening": FreqDaily,
+		"week": FreqWeekly, "weeks": FreqWeekly,
+		"month": FreqMonthly, "months": FreqMonthly,
+		"year": FreqYearly, "years": FreqYearly,
+	}
+
+	recurrenceAdverbs = map[string]Frequency{
+		"varje minut": FreqMinutely, "varje timme": FreqHourly, "dagligen": FreqDaily,
+		"veckovis": FreqWeekly, "månadsvis": FreqMonthly, "årligen": FreqYearly,
+		"hourly": FreqHourly, "daily": FreqDaily, "nightly": FreqDaily,
+		"weekly": FreqWeekly, "monthly": FreqMonthly, "yearly": FreqYearly, "annually": FreqYearly,
+	}
+
+	// "..., 10 gånger", "... 5 times"
+	recurrenceCountRegex = regexp.MustCompile(`^(.+?),? (?:totalt )?(\S+) (?:gånger|times)$`)
+
+	// "... till och med 31 december", "... until 2024-12-31"
+	recurrenceUntilRegex = regexp.MustCompile(`^(.+?),? (?:till och med|t\.o\.m\.?|tills|until|through) (.+)$`)
+
+	// "... kl 9", "... at 9 am"
+	recurrenceClockRegex = regexp.MustCompile(`^(.+?),? (?:kl\.?|klockan|at) (.+)$`)
+
+	// "9 och 17", "8 am, 12 and 5 pm"
+	recurrenceClockListRegex = regexp.MustCompile(`\s*(?:,|\boch\b|\band\b)\s*`)
+
+	// "9-17", "9 till 17", "9 am to 5 pm"
+	recurrenceClockRangeRegex = regexp.MustCompile(`^(.+?)\s*(?:-|–| till | to )\s*(.+)$`)
+
+	// "9", "09:30", "9.30", "9am", "5 pm"
+	clockDigitsRegex = regexp.MustCompile(`^\d{1,2}(?:[:.]\d{2})?(?: ?[ap]m)?$`)
+
+	// "den första tisdagen i varje månad", "the last friday of the month"
+	recurrenceMonthRegex = regexp.MustCompile(`^(.+?) (?:i |of )?(?:varje |every |each |the )?(?:månad|månaden|month)$`)
+
+	// "15:e", "15th", "1st"
+	ordinalDigitsRegex = regexp.MustCompile(`^(\d+)(?::?(?:st|nd|rd|th|e|a))?$`)
+
+	// "9am" => "9 am"
+	clockSuffixRegex = regexp.MustCompile(`(\d)(am|pm)$`)
+)
+
+// ParseRecurrence parses a repeating schedule starting today, see Parser.ParseRecurrence
+func ParseRecurrence(s string) (Recurrence, error) {
+	return NewParser(LocaleSvSE).ParseRecurrence(s)
+}
+
+// ParseRecurrence parses repeating schedules like "varje måndag kl 9", "varannan vecka
+// på fredag", "var tredje dag", "den första tisdagen varje månad", "every other week on
+// Friday" or "every 15 minutes", optionally ending in "till och med 31 december",
+// "until 2024-12-31", "10 gånger" or "5 times". The rule starts at the beginning of
+// the reference day
+func (p *Parser) ParseRecurrence(s string) (Recurrence, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	now := p.now()
+	r := Recurrence{Interval: 1, Start: beginningOfDay(now)}
+
+	if match := recurrenceCountRegex.FindStringSubmatch(s); match != nil {
+		n, err := ParseNumber(match[2])
+		if err != nil {
+			return r, err
+		}
+		r.Count = int(n.IntPart())
+		s = match[1]
+	}
+
+	if match := recurrenceUntilRegex.FindStringSubmatch(s); match != nil {
+		until, err := p.ParseTime(match[2])
+		if err != nil {
+			return r, err
+		}
+		// the whole last day is included
+		r.Until = time.Date(until.Year(), until.Month(), until.Day(), 23, 59, 59, 0, now.Location())
+		s = match[1]
+	}
+
+	hourRange := false
+	if match := recurrenceClockRegex.FindStringSubmatch(s); match != nil {
+		var err error
+		if hourRange, err = p.parseRecurrenceClock(match[2], &r); err != nil {
+			return r, err
+		}
+		s = match[1]
+	}
+
+	if err := parseRecurrenceRule(s, &r); err != nil {
+		return r, err
+	}
+	if hourRange {
+		switch r.Freq {
+		case FreqHourly:
+		case FreqMinutely:
+			// "var femte minut kl 9-17" runs all minutes of the hours
+			r.ByMinute = nil
+		default:
+			return r, fmt.Errorf("Cannot repeat over a range of hours: %s", s)
+		}
+	}
+	return r, nil
+}
+
+// parseRecurrenceClock parses the clock of a schedule into r, either a time like
+// "9:30", a list like "9 och 17" or a range of hours like "9-17". It returns true
+// for a range. Lists are only split when every time is in digits, since "arton
+// och trettio" is a single time
+func (p *Parser) parseRecurrenceClock(s string, r *Recurrence) (bool, error) {
+	if match := recurrenceClockRangeRegex.FindStringSubmatch(s); match != nil &&
+		clockDigitsRegex.MatchString(match[1]) && clockDigitsRegex.MatchString(match[2]) {
+		from, err := p.ParseTime(match[1])
+		if err != nil {
+			return false, err
+		}
+		to, err := p.ParseTime(match[2])
+		if err != nil {
+			return false, err
+		}
+		if to.Hour() < from.Hour() {
+			return false, fmt.Errorf("Invalid range of hours: %s", s)
+		}
+		r.ByHour = rangeInts(from.Hour(), to.Hour())
+		r.ByMinute = []int{from.Minute()}
+		return true, nil
+	}
+
+	clocks := []string{s}
+	if list := recurrenceClockListRegex.Split(s, -1); len(list) > 1 {
+		digits := true
+		for _, clock := range list {
+			digits = digits && clockDigitsRegex.MatchString(clock)
+		}
+		if digits {
+			clocks = list
+		}
+	}
+	r.ByHour, r.ByMinute = nil, nil
+	for _, clock := range clocks {
+		t, err := p.ParseTime(clock)
+		if err != nil {
+			return false, err
+		}
+		if len(r.ByMinute) > 0 && r.ByMinute[0] != t.Minute() {
+			// BYHOUR and BYMINUTE would combine into every pair
+			return false, fmt.Errorf("Cannot repeat at times with different minutes: %s", s)
+		}
+		if len(r.ByHour) == 0 || !containsInt(r.ByHour, t.Hour()) {
+			r.ByHour = append(r.ByHour, t.Hour())
+		}
+		r.ByMinute = []int{t.Minute()}
+	}
+	sort.Ints(r.ByHour)
+	return false, nil
+}
+
+// parseRecurrenceRule parses the frequency and day selection of s into r
+func parseRecurrenceRule(s string, r *Recurrence) error {
+	if freq, ok := recurrenceAdverbs[s]; ok {
+		r.Freq = freq
+		return nil
+	}
+
+	// "den första tisdagen varje månad", "den 15:e i varje månad"
+	if match := recurrenceMonthRegex.FindStringSubmatch(s); match != nil {
+		if parseMonthSelection(match[1], r) == nil {
+			r.Freq = FreqMonthly
+			return nil
+		}
+	}
+
+	rest := ""
+	switch {
+	case strings.HasPrefix(s, "varje "):
+		rest = s[6:]
+	case strings.HasPrefix(s, "varannan "):
+		r.Interval, rest = 2, s[9:]
+	case strings.HasPrefix(s, "every other "):
+		r.Interval, rest = 2, s[12:]
+	case strings.HasPrefix(s, "var "), strings.HasPrefix(s, "every "):
+		// "var tredje dag", "every third day", "every 15 minutes"
+		parts := strings.SplitN(s, " ", 3)
+		n, err := parseOrdinal(parts[1])
+		if err != nil || n < 1 || len(parts) < 3 {
+			if parts[0] == "var" {
+				return fmt.Errorf("Cannot parse recurrence: %s", s)
+			}
+			rest = s[6:]
+			break
+		}
+		r.Interval, rest = n, parts[2]
+	default:
+		return fmt.Errorf("Cannot parse recurrence: %s", s)
+	}
+
+	parts := strings.SplitN(rest, " ", 2)
+	unit := parts[0]
+	detail := ""
+	if len(parts) == 2 {
+		detail = parts[1]
+	}
+
+	if unit == "vardag" || unit == "vardagar" || unit == "weekday" || unit == "weekdays" {
+		// "varje vardag", "every weekday"
+		r.Freq = FreqWeekly
+		for _, wd := range workWeek {
+			r.ByDay = append(r.ByDay, NthWeekday{Weekday: wd})
+		}
+		return nil
+	}
+
+	if freq, ok := recurrenceUnits[unit]; ok {
+		r.Freq = freq
+		if detail == "" {
+			return nil
+		}
+		// "varannan vecka på fredag", "every month on the 15th"
+		detail = strings.TrimPrefix(strings.TrimPrefix(detail, "på "), "on ")
+		switch freq {
+		case FreqWeekly:
+			days, err := parseWeekdayList(detail)
+			if err != nil {
+				return err
+			}
+			for _, wd := range days {
+				r.ByDay = append(r.ByDay, NthWeekday{Weekday: wd})
+			}
+			return nil
+		case FreqMonthly:
+			return parseMonthSelection(detail, r)
+		}
+		return fmt.Errorf("Cannot parse recurrence: %s", s)
+	}
+
+	// "varje måndag och onsdag", "varannan fredag", "every monday"
+	days, err := parseWeekdayList(rest)
+	if err != nil {
+		return err
+	}
+	r.Freq = FreqWeekly
+	for _, wd := range days {
+		r.ByDay = append(r.ByDay, NthWeekday{Weekday: wd})
+	}
+	return nil
+}
+
+// parseMonthSelection parses a day of the month, "den 15:e", "the last day",
+// or a weekday in the month, "den första tisdagen", "the last friday"
+func parseMonthSelection(s string, r *Recurrence) error {
+	for _, prefix := range []string{"on the ", "the ", "den "} {
+		s = strings.TrimPrefix(s, prefix)
+	}
+	parts := strings.Split(s, " ")
+	if len(parts) > 2 {
+		return fmt.Errorf("Cannot parse day of month: %s", s)
+	}
+	n, err := parseOrdinal(parts[0])
+	if err != nil || n == 0 || n > 31 {
+		return fmt.Errorf("Cannot parse day of month: %s", s)
+	}
+	if len(parts) == 1 || parts[1] == "dagen" || parts[1] == "dag" || parts[1] == "day" {
+		r.ByMonthDay = []int{n}
+		return nil
+	}
+	wd, err := parseWeekdayPlural(parts[1])
+	if err != nil || n > 5 {
+		return fmt.Errorf("Cannot parse day of month: %s", s)
+	}
+	r.ByDay = []NthWeekday{{N: n, Weekday: wd}}
+	return nil
+}
+
+// parseOrdinal parses "tredje", "third", "3", "3:e", "3rd" and "sista"/"last", which is -1
+func parseOrdinal(s string) (int, error) {
+	if s == "sista" || s == "last" {
+		return -1, nil
+	}
+	if match := ordinalDigitsRegex.FindStringSubmatch(s); match != nil {
+		return strconv.Atoi(match[1])
+	}
+	if n, err := ParseCount(s); err == nil {
+		return int(n.IntPart()), nil
+	}
+	n, err := ParseNumber(s)
+	if err != nil {
+		return 0, err
+	}
+	return int(n.IntPart()), nil
+}
+
+// parseWeekdayPlural parses a weekday, also in plural, "måndagar", "mondays"
+func parseWeekdayPlural(s string) (time.Weekday, error) {
+	if wd, err := ParseWeekday(s); err == nil {
+		return wd, nil
+	}
+	if strings.HasSuffix(s, "ar") {
+		return ParseWeekday(strings.TrimSuffix(s, "ar"))
+	}
+	return ParseWeekday(strings.TrimSuffix(s, "s"))
+}
+
+// parseWeekdayList parses "måndag och onsdag", "mån, ons och fre", "mån-fre"
+// or "monday, wednesday and friday" into weekdays, in order of mention
+func parseWeekdayList(s string) ([]time.Weekday, error) {
+	s = strings.NewReplacer(" och ", ",", " and ", ",", " & ", ",", "–", "-").Replace(s)
+	var res []time.Weekday
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if bounds := strings.Split(item, "-"); len(bounds) == 2 {
+			from, err := parseWeekdayPlural(strings.TrimSpace(bounds[0]))
+			if err != nil {
+				return nil, err
+			}
+			to, err := parseWeekdayPlural(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				return nil, err
+			}
+			for wd := from; ; wd = (wd + 1) % 7 {
+				res = append(res, wd)
+				if wd == to {
+					break
+				}
+			}
+			continue
+		}
+		wd, err := parseWeekdayPlural(item)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, wd)
+	}
+	return res, nil
+}
+
+// String returns the rule in RFC 5545 RRULE form, such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR"
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if len(r.ByMonth) > 0 {
+		months := []int{}
+		for _, m := range r.ByMonth {
+			months = append(months, int(m))
+		}
+		parts = append(parts, "BYMONTH="+joinInts(months))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		days := []string{}
+		for _, d := range r.ByDay {
+			day := rruleWeekdays[d.Weekday]
+			if d.N != 0 {
+				day = strconv.Itoa(d.N) + day
+			}
+			days = append(days, day)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByHour) > 0 {
+		parts = append(parts, "BYHOUR="+joinInts(r.ByHour))
+	}
+	if len(r.ByMinute) > 0 {
+		parts = append(parts, "BYMINUTE="+joinInts(r.ByMinute))
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102T150405"))
+	}
+	return strings.Join(parts, ";")
+}
+
+func joinInts(list []int) string {
+	s := []string{}
+	for _, i := range list {
+		s = append(s, strconv.Itoa(i))
+	}
+	return strings.Join(s, ",")
+}
+
+// Next returns the first occurrence after t, or false when the rule has ended
+func (r Recurrence) Next(t time.Time) (time.Time, bool) {
+	var res time.Time
+	found := false
+	r.iterate(func(occ time.Time) bool {
+		if occ.After(t) {
+			res, found = occ, true
+			return false
+		}
+		return true
+	})
+	return res, found
+}
+
+// Occurrences returns up to n occurrences after t
+func (r Recurrence) Occurrences(t time.Time, n int) []time.Time {
+	var res []time.Time
+	if n <= 0 {
+		return res
+	}
+	r.iterate(func(occ time.Time) bool {
+		if occ.After(t) {
+			res = append(res, occ)
+		}
+		return len(res) < n
+	})
+	return res
+}
+
+// recurrenceHorizon bounds the search for occurrences, in days
+const recurrenceHorizon = 100 * 366
+
+// iterate calls fn with each occurrence in order, from Start until fn returns false,
+// Count or Until is reached, or the horizon is passed
+func (r Recurrence) iterate(fn func(time.Time) bool) {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	count := 0
+	emit := func(occ time.Time) bool {
+		if !r.Until.IsZero() && occ.After(r.Until) {
+			return false
+		}
+		count++
+		if !fn(occ) {
+			return false
+		}
+		return r.Count == 0 || count < r.Count
+	}
+
+	if r.Freq == FreqMinutely || r.Freq == FreqHourly {
+		r.iterateClock(interval, emit)
+		return
+	}
+
+	hours, minutes := r.ByHour, r.ByMinute
+	if len(hours) == 0 {
+		hours = []int{r.Start.Hour()}
+	}
+	if len(minutes) == 0 {
+		minutes = []int{r.Start.Minute()}
+	}
+	clocks := []int{}
+	for _, h := range hours {
+		for _, m := range minutes {
+			clocks = append(clocks, h*60+m)
+		}
+	}
+	sort.Ints(clocks)
+
+	first := beginningOfDay(r.Start)
+	for i := 0; i < recurrenceHorizon; i++ {
+		day := addDay(first, i)
+		if !r.matchesInterval(first, day, interval) || !r.matchesDay(day) {
+			continue
+		}
+		for _, c := range clocks {
+			occ := time.Date(day.Year(), day.Month(), day.Day(), c/60, c%60, 0, 0, day.Location())
+			if occ.Before(r.Start) {
+				continue
+			}
+			if !emit(occ) {
+				return
+			}
+		}
+	}
+}
+
+// iterateClock emits the minutely and hourly occurrences of r, each day expanded
+// over the hours and minutes allowed by ByHour and ByMinute. Minutes and hours are
+// counted by interval on the wall clock from Start
+func (r Recurrence) iterateClock(interval int, emit func(time.Time) bool) {
+	hours, minutes := r.ByHour, r.ByMinute
+	if len(hours) == 0 {
+		hours = rangeInts(0, 23)
+	}
+	if len(minutes) == 0 && r.Freq == FreqHourly {
+		minutes = []int{r.Start.Minute()}
+	}
+	if len(minutes) == 0 {
+		minutes = rangeInts(0, 59)
+	}
+	clocks := []int{}
+	for _, h := range hours {
+		for _, m := range minutes {
+			clocks = append(clocks, h*60+m)
+		}
+	}
+	sort.Ints(clocks)
+
+	first := beginningOfDay(r.Start)
+	for i := 0; i < recurrenceHorizon; i++ {
+		day := addDay(first, i)
+		if !r.matchesDay(day) {
+			continue
+		}
+		for _, c := range clocks {
+			// minutes, or hours, since the start
+			n := i*24*60 + c - (r.Start.Hour()*60 + r.Start.Minute())
+			if r.Freq == FreqHourly {
+				n = i*24 + c/60 - r.Start.Hour()
+			}
+			if n < 0 || n%interval != 0 {
+				continue
+			}
+			occ := time.Date(day.Year(), day.Month(), day.Day(), c/60, c%60, 0, 0, day.Location())
+			if occ.Before(r.Start) {
+				continue
+			}
+			if !emit(occ) {
+				return
+			}
+		}
+	}
+}
+
+// rangeInts returns the integers from min to max
+func rangeInts(min, max int) []int {
+	res := []int{}
+	for i := min; i <= max; i++ {
+		res = append(res, i)
+	}
+	return res
+}
+
+// containsInt returns true if list is empty or contains i
+func containsInt(list []int, i int) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, v := range list {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesInterval returns true if day is in a period counted by interval from first
+func (r Recurrence) matchesInterval(first, day time.Time, interval int) bool {
+	n := 0
+	switch r.Freq {
+	case FreqDaily:
+		n = int(day.Sub(first).Hours()+12) / 24
+	case FreqWeekly:
+		n = int(StartOfWeek(day, LocaleSvSE).Sub(StartOfWeek(first, LocaleSvSE)).Hours()+12) / (24 * 7)
+	case FreqMonthly:
+		n = (day.Year()-first.Year())*12 + int(day.Month()-first.Month())
+	case FreqYearly:
+		n = day.Year() - first.Year()
+	}
+	return n%interval == 0
+}
+
+// matchesDay returns true if the date of t is selected by the rule
+func (r Recurrence) matchesDay(t time.Time) bool {
+	if len(r.ByMonth) > 0 {
+		found := false
+		for _, m := range r.ByMonth {
+			found = found || m == t.Month()
+		}
+		if !found {
+			return false
+		}
+	} else if r.Freq == FreqYearly && t.Month() != r.Start.Month() {
+		return false
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		last := addDay(time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location()), -1).Day()
+		for _, d := range r.ByMonthDay {
+			if d == t.Day() || (d < 0 && last+d+1 == t.Day()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(r.ByDay) > 0 {
+		last := addDay(time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location()), -1).Day()
+		for _, d := range r.ByDay {
+			if d.Weekday != t.Weekday() {
+				continue
+			}
+			switch {
+			case d.N == 0:
+				return true
+			case d.N > 0 && (t.Day()-1)/7+1 == d.N:
+				return true
+			case d.N < 0 && (last-t.Day())/7+1 == -d.N:
+				return true
+			}
+		}
+		return false
+	}
+
+	switch r.Freq {
+	case FreqWeekly:
+		return t.Weekday() == r.Start.Weekday()
+	case FreqMonthly, FreqYearly:
+		return t.Day() == r.Start.Day()
+	}
+	return true
+}
+
+// ParseRRule parses an RFC 5545 RRULE such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR",
+// with or without the "RRULE:" prefix. The rule starts at the beginning of today
+func ParseRRule(s string) (Recurrence, error) {
+	r := Recurrence{Interval: 1, Start: beginningOfDay(time.Now())}
+	s = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(s)), "RRULE:")
+	hasFreq := false
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return r, fmt.Errorf("Cannot parse RRULE part: %s", part)
+		}
+		var err error
+		switch kv[0] {
+		case "FREQ":
+			idx, e := arrayIndex(kv[1], frequencyNames)
+			r.Freq, err, hasFreq = Frequency(idx), e, true
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(kv[1])
+		case "COUNT":
+			r.Count, err = strconv.Atoi(kv[1])
+		case "UNTIL":
+			r.Until, err = parseRRuleTime(kv[1])
+		case "BYMONTH":
+			var months []int
+			months, err = splitInts(kv[1])
+			for _, m := range months {
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = splitInts(kv[1])
+		case "BYHOUR":
+			r.ByHour, err = splitInts(kv[1])
+		case "BYMINUTE":
+			r.ByMinute, err = splitInts(kv[1])
+		case "BYDAY":
+			for _, day := range strings.Split(kv[1], ",") {
+				if len(day) < 2 {
+					return r, fmt.Errorf("Cannot parse BYDAY: %s", kv[1])
+				}
+				idx, e := arrayIndex(day[len(day)-2:], rruleWeekdays)
+				if e != nil {
+					return r, fmt.Errorf("Cannot parse BYDAY: %s", kv[1])
+				}
+				d := NthWeekday{Weekday: time.Weekday(idx)}
+				if n := strings.TrimPrefix(day[:len(day)-2], "+"); n != "" {
+					if d.N, err = strconv.Atoi(n); err != nil {
+						return r, err
+					}
+				}
+				r.ByDay = append(r.ByDay, d)
+			}
+		case "WKST", "DTSTART":
+		default:
+			return r, fmt.Errorf("Unsupported RRULE part: %s", part)
+		}
+		if err != nil {
+			return r, err
+		}
+	}
+	if !hasFreq {
+		return r, fmt.Errorf("RRULE without FREQ: %s", s)
+	}
+	return r, nil
+}
+
+// parseRRuleTime parses "20240630", "20240630T235959" or "20240630T235959Z"
+func parseRRuleTime(s string) (time.Time, error) {
+	if strings.HasSuffix(s, "Z") {
+		return time.Parse("20060102T150405Z", s)
+	}
+	if len(s) == 8 {
+		t, err := time.ParseInLocation("20060102", s, time.Local)
+		return t.Add(24*time.Hour - time.Second), err
+	}
+	return time.ParseInLocation("20060102T150405", s, time.Local)
+}
+
+func splitInts(s string) ([]int, error) {
+	var res []int
+	for _, part := range strings.Split(s, ",") {
+		i, err := strconv.Atoi(strings.TrimPrefix(part, "+"))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, i)
+	}
+	return res, nil
+}
@@ -0,0 +1,157 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	expected := map[string]string{
+		// swe
+		"varje måndag kl 9":                   "FREQ=WEEKLY;BYDAY=MO;BYHOUR=9;BYMINUTE=0",
+		"varannan vecka på fredag":            "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR",
+		"varannan fredag":                     "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR",
+		"var tredje dag":                      "FREQ=DAILY;INTERVAL=3",
+		"var femtonde minut":                  "FREQ=MINUTELY;INTERVAL=15",
+		"varje timme":                         "FREQ=HOURLY",
+		"dagligen":                            "FREQ=DAILY",
+		"varje natt kl 03":                    "FREQ=DAILY;BYHOUR=3;BYMINUTE=0",
+		"varje vardag kl 08:30":               "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=8;BYMINUTE=30",
+		"varje mån, ons och fre":              "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+		"varje måndag och torsdag kl 18":      "FREQ=WEEKLY;BYDAY=MO,TH;BYHOUR=18;BYMINUTE=0",
+		"den första tisdagen varje månad":     "FREQ=MONTHLY;BYDAY=1TU",
+		"sista fredagen i varje månad kl 15":  "FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=15;BYMINUTE=0",
+		"den 25:e varje månad":                "FREQ=MONTHLY;BYMONTHDAY=25",
+		"första dagen i varje månad kl 06:00": "FREQ=MONTHLY;BYMONTHDAY=1;BYHOUR=6;BYMINUTE=0",
+		"varje vecka till och med 2024-06-30": "FREQ=WEEKLY;UNTIL=20240630T235959",
+		"varje tisdag, 10 gånger":             "FREQ=WEEKLY;BYDAY=TU;COUNT=10",
+		"varannan månad":                      "FREQ=MONTHLY;INTERVAL=2",
+		"varje år":                            "FREQ=YEARLY",
+		"varje dag kl 9 och 17":               "FREQ=DAILY;BYHOUR=9,17;BYMINUTE=0",
+		"varje timme kl 9-17":                 "FREQ=HOURLY;BYHOUR=9,10,11,12,13,14,15,16,17;BYMINUTE=0",
+		"var femte minut kl 9-11":             "FREQ=MINUTELY;INTERVAL=5;BYHOUR=9,10,11",
+		"varje dag kl arton och trettio":      "FREQ=DAILY;BYHOUR=18;BYMINUTE=30",
+		// eng
+		"every monday at 9am":              "FREQ=WEEKLY;BYDAY=MO;BYHOUR=9;BYMINUTE=0",
+		"every other week on friday":       "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR",
+		"every third day":                  "FREQ=DAILY;INTERVAL=3",
+		"every 15 minutes":                 "FREQ=MINUTELY;INTERVAL=15",
+		"every weekday at 5 pm":            "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=17;BYMINUTE=0",
+		"every tuesday and thursday":       "FREQ=WEEKLY;BYDAY=TU,TH",
+		"the first tuesday of every month": "FREQ=MONTHLY;BYDAY=1TU",
+		"the last friday of the month":     "FREQ=MONTHLY;BYDAY=-1FR",
+		"every month on the 15th":          "FREQ=MONTHLY;BYMONTHDAY=15",
+		"every day until 2024-04-30":       "FREQ=DAILY;UNTIL=20240430T235959",
+		"every sunday, 5 times":            "FREQ=WEEKLY;BYDAY=SU;COUNT=5",
+		"weekly":                           "FREQ=WEEKLY",
+		"every day at 8am and 5pm":         "FREQ=DAILY;BYHOUR=8,17;BYMINUTE=0",
+	}
+	for in, expect := range expected {
+		r, err := p.ParseRecurrence(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, expect, r.String(), in)
+	}
+
+	for _, in := range []string{"ibland", "var blå dag", "every blue moon", "varje dag kl 9-17", "varje dag kl 9 och 17:30", "varje timme kl 17-9"} {
+		_, err := p.ParseRecurrence(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	p := testParser(LocaleSvSE)
+	now := p.now()
+	expected := map[string][]string{
+		"varje måndag kl 9":               {"2024-04-22 09:00", "2024-04-29 09:00", "2024-05-06 09:00"},
+		"varannan fredag":                 {"2024-04-19 00:00", "2024-05-03 00:00", "2024-05-17 00:00"},
+		"var tredje dag":                  {"2024-04-20 00:00", "2024-04-23 00:00", "2024-04-26 00:00"},
+		"varje dag kl 15":                 {"2024-04-18 15:00", "2024-04-19 15:00", "2024-04-20 15:00"},
+		"varje dag kl 16":                 {"2024-04-17 16:00", "2024-04-18 16:00", "2024-04-19 16:00"},
+		"var femtonde minut":              {"2024-04-17 15:45", "2024-04-17 16:00", "2024-04-17 16:15"},
+		"den första tisdagen varje månad": {"2024-05-07 00:00", "2024-06-04 00:00", "2024-07-02 00:00"},
+		"sista fredagen i varje månad":    {"2024-04-26 00:00", "2024-05-31 00:00", "2024-06-28 00:00"},
+		"den 31:a varje månad":            {"2024-05-31 00:00", "2024-07-31 00:00", "2024-08-31 00:00"},
+		"varje onsdag, 2 gånger":          {"2024-04-24 00:00"},
+		"every day until 2024-04-19":      {"2024-04-18 00:00", "2024-04-19 00:00"},
+	}
+	for in, expect := range expected {
+		r, err := p.ParseRecurrence(in)
+		assert.Equal(t, nil, err, in)
+		res := []string{}
+		for _, occ := range r.Occurrences(now, 3) {
+			res = append(res, occ.Format("2006-01-02 15:04"))
+		}
+		assert.Equal(t, expect, res, in)
+	}
+
+	r, _ := p.ParseRecurrence("varje måndag kl 9")
+	next, ok := r.Next(time.Date(2024, 4, 22, 9, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, "2024-04-29 09:00", next.Format("2006-01-02 15:04"))
+
+	r, _ = p.ParseRecurrence("every day until 2024-04-19")
+	_, ok = r.Next(time.Date(2024, 4, 19, 12, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestRecurrenceOccurrencesClock(t *testing.T) {
+	start := time.Date(2024, 4, 17, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 4, 17, 15, 30, 0, 0, time.UTC)
+	expected := map[string][]string{
+		"30 * * * *":      {"2024-04-17 16:30", "2024-04-17 17:30", "2024-04-17 18:30"},
+		"15 */2 * * *":    {"2024-04-17 16:15", "2024-04-17 18:15", "2024-04-17 20:15"},
+		"0,45 */3 * * *":  {"2024-04-17 15:45", "2024-04-17 18:00", "2024-04-17 18:45"},
+		"*/20 9-10 * * *": {"2024-04-18 09:00", "2024-04-18 09:20", "2024-04-18 09:40"},
+	}
+	for in, expect := range expected {
+		r, err := ParseCron(in)
+		assert.Equal(t, nil, err, in)
+		r.Start = start
+		res := []string{}
+		for _, occ := range r.Occurrences(now, 3) {
+			res = append(res, occ.Format("2006-01-02 15:04"))
+		}
+		assert.Equal(t, expect, res, in)
+	}
+
+	r, err := ParseRRule("FREQ=HOURLY;BYMINUTE=30")
+	assert.Equal(t, nil, err)
+	r.Start = start
+	next, ok := r.Next(now)
+	assert.True(t, ok)
+	assert.Equal(t, "2024-04-17 16:30", next.Format("2006-01-02 15:04"))
+
+	r, err = ParseRRule("FREQ=MINUTELY;INTERVAL=10;BYHOUR=9")
+	assert.Equal(t, nil, err)
+	r.Start = start
+	next, ok = r.Next(now)
+	assert.True(t, ok)
+	assert.Equal(t, "2024-04-18 09:00", next.Format("2006-01-02 15:04"))
+}
+
+func TestParseRRule(t *testing.T) {
+	rules := []string{
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=FR",
+		"FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=15;BYMINUTE=0",
+		"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24",
+		"FREQ=DAILY;COUNT=10",
+		"FREQ=WEEKLY;UNTIL=20240630T235959",
+	}
+	for _, in := range rules {
+		r, err := ParseRRule(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, in, r.String(), in)
+	}
+
+	r, err := ParseRRule("RRULE:FREQ=MONTHLY;BYDAY=+1TU;WKST=MO")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "FREQ=MONTHLY;BYDAY=1TU", r.String())
+
+	for _, in := range []string{"", "INTERVAL=2", "FREQ=FORTNIGHTLY", "FREQ=WEEKLY;BYDAY=XX", "FREQ=DAILY;BYSETPOS=1"} {
+		_, err := ParseRRule(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
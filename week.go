@@ -0,0 +1,123 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	// "vecka 12", "v. 12 2024", "week 12", "tisdag vecka 12"
+	weekRegex = regexp.MustCompile(`^(?:(?P<weekday>\pL+) )?(?:vecka|v\.?|week|wk\.?) ?(?P<week>\d{1,2})(?:,? (?P<year>\d{2,4}))?$`)
+)
+
+// FirstDayOfWeek returns the first day of the week in locale
+func FirstDayOfWeek(locale string) time.Weekday {
+	switch locale {
+	case LocaleEnUS:
+		return time.Sunday
+	}
+	return time.Monday
+}
+
+// StartOfWeek returns midnight on the first day of the week containing t, as
+// counted in locale
+func StartOfWeek(t time.Time, locale string) time.Time {
+	diff := (int(t.Weekday()) - int(FirstDayOfWeek(locale)) + 7) % 7
+	return addDay(t, -diff)
+}
+
+// ISOWeekStart returns midnight on the monday of ISO 8601 week in year
+func ISOWeekStart(year, week int, loc *time.Location) time.Time {
+	// january 4th is always in week 1
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, loc)
+	monday := addDay(jan4, -((int(jan4.Weekday()) + 6) % 7))
+	return addDay(monday, (week-1)*7)
+}
+
+// ParseWeek parses an ISO week expression relative to the current time,
+// see Parser.ParseWeek
+func ParseWeek(s string) (time.Time, error) {
+	return NewParser(LocaleSvSE).ParseWeek(s)
+}
+
+// ParseWeek parses ISO week expressions like "vecka 12", "v. 12 2024",
+// "week 12" or "tisdag vecka 12" into the monday (or given weekday) of that
+// week. Without a year, the week is in the ISO year of the reference time
+func (p *Parser) ParseWeek(s string) (time.Time, error) {
+	match := weekRegex.FindStringSubmatch(s)
+	if match == nil {
+		return time.Time{}, fmt.Errorf("Cannot parse week: %s", s)
+	}
+
+	now := p.now()
+	year, _ := now.ISOWeek()
+	if match[3] != "" {
+		var err error
+		if year, err = ParseYear(match[3]); err != nil {
+			return time.Time{}, err
+		}
+	}
+	week, err := strconv.Atoi(match[2])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t := ISOWeekStart(year, week, now.Location())
+	if y, w := t.ISOWeek(); week < 1 || y != year || w != week {
+		return time.Time{}, fmt.Errorf("Invalid week: %d %d", week, year)
+	}
+
+	if match[1] != "" {
+		wd, err := ParseWeekday(match[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		// ISO weeks begin on monday
+		t = addDay(t, (int(wd)+6)%7)
+	}
+	return t, nil
+}
+
+// PresentWeek returns the ISO week of t, such as "v. 12" (DateShort),
+// "vecka 12" (DateLong) or "vecka tolv" (DateSpelledOut). The year is
+// included if it is not the current year, or with DateWithYear
+func PresentWeek(t time.Time, locale string, style DateStyle) string {
+	year, week := t.ISOWeek()
+	currentYear, _ := time.Now().ISOWeek()
+	withYear := style&DateWithYear != 0 || year != currentYear
+	short := style&dateBaseStyleMask == DateShort && style&DateSpelledOut == 0
+
+	s := ""
+	switch locale {
+	case LocaleSvSE:
+		switch {
+		case style&DateSpelledOut != 0:
+			s = "vecka " + PresentSvSE(int64(week))
+		case short:
+			s = fmt.Sprintf("v. %d", week)
+		default:
+			s = fmt.Sprintf("vecka %d", week)
+		}
+	default:
+		switch {
+		case style&DateSpelledOut != 0:
+			s = "week " + PresentEnUS(int64(week))
+		case short:
+			s = fmt.Sprintf("wk %d", week)
+		default:
+			s = fmt.Sprintf("week %d", week)
+		}
+	}
+
+	switch {
+	case withYear && style&DateSpelledOut != 0 && locale == LocaleSvSE:
+		s += " " + presentYearSvSE(year)
+	case withYear && style&DateSpelledOut != 0:
+		s += " " + presentYearEnUS(year)
+	case withYear:
+		s += fmt.Sprintf(" %d", year)
+	}
+	return s
+}
@@ -0,0 +1,70 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWeek(t *testing.T) {
+	expected := map[string]string{
+		// input, expected date
+		"vecka 12 2024":        "2024-03-18",
+		"v. 12 2024":           "2024-03-18",
+		"v.12 2024":            "2024-03-18",
+		"v 1 2021":             "2021-01-04",
+		"week 12, 2024":        "2024-03-18",
+		"tisdag vecka 12 2024": "2024-03-19",
+		"söndag v. 12 2024":    "2024-03-24",
+		"friday week 53 2020":  "2021-01-01",
+		"week 1 2025":          "2024-12-30",
+	}
+	for s, expect := range expected {
+		tm, err := ParseWeek(s)
+		assert.Equal(t, nil, err, s)
+		assert.Equal(t, expect, tm.Format("2006-01-02"), s)
+	}
+
+	year, _ := time.Now().ISOWeek()
+	tm, err := ParseWeek("vecka 12")
+	assert.Equal(t, nil, err)
+	y, w := tm.ISOWeek()
+	assert.Equal(t, year, y)
+	assert.Equal(t, 12, w)
+	assert.Equal(t, time.Monday, tm.Weekday())
+
+	for _, s := range []string{"vecka 53 2021", "vecka 0", "vecka", "fredag"} {
+		_, err = ParseWeek(s)
+		assert.NotEqual(t, nil, err, s)
+	}
+
+	tm, err = ParseTime("tisdag vecka 12 2024")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-03-19", tm.Format("2006-01-02"))
+}
+
+func TestStartOfWeek(t *testing.T) {
+	wed := time.Date(2024, time.March, 20, 15, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Monday, FirstDayOfWeek(LocaleSvSE))
+	assert.Equal(t, time.Sunday, FirstDayOfWeek(LocaleEnUS))
+	assert.Equal(t, "2024-03-18 00:00", StartOfWeek(wed, LocaleSvSE).Format("2006-01-02 15:04"))
+	assert.Equal(t, "2024-03-17 00:00", StartOfWeek(wed, LocaleEnUS).Format("2006-01-02 15:04"))
+
+	sun := time.Date(2024, time.March, 24, 15, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2024-03-18", StartOfWeek(sun, LocaleSvSE).Format("2006-01-02"))
+	assert.Equal(t, "2024-03-24", StartOfWeek(sun, LocaleEnUS).Format("2006-01-02"))
+}
+
+func TestPresentWeek(t *testing.T) {
+	tm := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "v. 12 2024", PresentWeek(tm, LocaleSvSE, DateShort))
+	assert.Equal(t, "vecka 12 2024", PresentWeek(tm, LocaleSvSE, DateLong))
+	assert.Equal(t, "vecka tolv tjugohundratjugofyra", PresentWeek(tm, LocaleSvSE, DateSpelledOut))
+	assert.Equal(t, "week 12 2024", PresentWeek(tm, LocaleEnUS, DateLong))
+
+	now := time.Now()
+	_, week := now.ISOWeek()
+	assert.Equal(t, "vecka "+PresentSvSE(int64(week)), PresentWeek(now, LocaleSvSE, DateSpelledOut))
+	assert.Equal(t, "week "+PresentEnUS(int64(week)), PresentWeek(now, LocaleEnUS, DateSpelledOut))
+}
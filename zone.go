@@ -0,0 +1,181 @@
+package natural
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// zones by name, in Swedish and English
+	namedZones = map[string]string{
+		"svensk tid":      "Europe/Stockholm",
+		"swedish time":    "Europe/Stockholm",
+		"norsk tid":       "Europe/Oslo",
+		"norwegian time":  "Europe/Oslo",
+		"dansk tid":       "Europe/Copenhagen",
+		"danish time":     "Europe/Copenhagen",
+		"finsk tid":       "Europe/Helsinki",
+		"finnish time":    "Europe/Helsinki",
+		"brittisk tid":    "Europe/London",
+		"engelsk tid":     "Europe/London",
+		"british time":    "Europe/London",
+		"uk time":         "Europe/London",
+		"östkusttid":      "America/New_York",
+		"eastern time":    "America/New_York",
+		"central time":    "America/Chicago",
+		"mountain time":   "America/Denver",
+		"västkusttid":     "America/Los_Angeles",
+		"pacific time":    "America/Los_Angeles",
+		"utc":             "UTC",
+		"gmt":             "UTC",
+		"z":               "UTC",
+		"universal time":  "UTC",
+		"koordinerad tid": "UTC",
+	}
+
+	// common abbreviations, as offsets in hours from UTC
+	zoneAbbreviations = map[string]int{
+		"wet":  0,
+		"west": 1,
+		"cet":  1,
+		"cest": 2,
+		"eet":  2,
+		"eest": 3,
+		"bst":  1,
+		"est":  -5,
+		"edt":  -4,
+		"cst":  -6,
+		"cdt":  -5,
+		"mst":  -7,
+		"mdt":  -6,
+		"pst":  -8,
+		"pdt":  -7,
+	}
+
+	// display names used by PresentZone
+	zoneNames = map[string]map[string]string{
+		LocaleSvSE: {
+			"Europe/Stockholm":    "svensk tid",
+			"Europe/Oslo":         "norsk tid",
+			"Europe/Copenhagen":   "dansk tid",
+			"Europe/Helsinki":     "finsk tid",
+			"Europe/London":       "brittisk tid",
+			"America/New_York":    "östkusttid",
+			"America/Los_Angeles": "västkusttid",
+			"UTC":                 "UTC",
+		},
+		LocaleEnUS: {
+			"Europe/Stockholm":    "Swedish time",
+			"Europe/Oslo":         "Norwegian time",
+			"Europe/Copenhagen":   "Danish time",
+			"Europe/Helsinki":     "Finnish time",
+			"Europe/London":       "UK time",
+			"America/New_York":    "Eastern Time",
+			"America/Chicago":     "Central Time",
+			"America/Denver":      "Mountain Time",
+			"America/Los_Angeles": "Pacific Time",
+			"UTC":                 "UTC",
+		},
+	}
+
+	// "UTC+2", "GMT-05:30", "+02:00", "utc−3"
+	zoneOffsetRegex = regexp.MustCompile(`^(utc|gmt)? ?([+\-−])(\d{1,2})(?::?(\d{2}))?$`)
+)
+
+// ParseLocation parses a time zone, such as "Europe/Stockholm", "CET", "svensk tid",
+// "Eastern Time", "UTC+2" or "GMT". Abbreviations and offsets give fixed zones
+// without daylight saving time
+func ParseLocation(s string) (*time.Location, error) {
+	s = strings.TrimSpace(s)
+	lower := strings.ToLower(s)
+
+	if name, ok := namedZones[lower]; ok {
+		return time.LoadLocation(name)
+	}
+
+	if hours, ok := zoneAbbreviations[lower]; ok {
+		return time.FixedZone(strings.ToUpper(s), hours*60*60), nil
+	}
+
+	// a bare "-05" would be a year, so offsets without a prefix need minutes
+	if match := zoneOffsetRegex.FindStringSubmatch(lower); match != nil && (match[1] != "" || match[4] != "") {
+		hours, _ := strconv.Atoi(match[3])
+		minutes, _ := strconv.Atoi(match[4])
+		if hours > 14 || minutes > 59 {
+			return nil, fmt.Errorf("Invalid zone offset: %s", s)
+		}
+		sign := "+"
+		if match[2] != "+" {
+			sign = "-"
+		}
+		name := fmt.Sprintf("UTC%s%d", sign, hours)
+		if minutes != 0 {
+			name += fmt.Sprintf(":%02d", minutes)
+		}
+		offset := hours*60*60 + minutes*60
+		if sign == "-" {
+			offset = -offset
+		}
+		return time.FixedZone(name, offset), nil
+	}
+
+	// IANA names, "Europe/Stockholm", "america/new_york"
+	if strings.Contains(s, "/") {
+		if loc, err := time.LoadLocation(s); err == nil {
+			return loc, nil
+		}
+		if loc, err := time.LoadLocation(titleZoneName(lower)); err == nil {
+			return loc, nil
+		}
+	}
+	return nil, fmt.Errorf("Unknown time zone: %s", s)
+}
+
+// titleZoneName capitalizes each part of an IANA name, "america/new_york" is "America/New_York"
+func titleZoneName(s string) string {
+	titled := func(sep string, parts []string) string {
+		for i, part := range parts {
+			if part != "" {
+				parts[i] = strings.ToUpper(part[:1]) + part[1:]
+			}
+		}
+		return strings.Join(parts, sep)
+	}
+	segments := strings.Split(s, "/")
+	for i, seg := range segments {
+		segments[i] = titled("_", strings.Split(seg, "_"))
+	}
+	return strings.Join(segments, "/")
+}
+
+// splitZone splits a trailing time zone of up to three words from s,
+// "kl 15 svensk tid" is "kl 15" in Europe/Stockholm
+func splitZone(s string) (string, *time.Location, bool) {
+	words := strings.Split(s, " ")
+	for n := 1; n <= 3 && n < len(words); n++ {
+		zone := strings.Join(words[len(words)-n:], " ")
+		zone = strings.TrimSuffix(strings.TrimPrefix(zone, "("), ")")
+		if loc, err := ParseLocation(zone); err == nil {
+			rest := strings.TrimSuffix(strings.Join(words[:len(words)-n], " "), ",")
+			return rest, loc, true
+		}
+	}
+	return s, nil, false
+}
+
+// PresentZone returns the time zone of t in words, such as "svensk tid" or
+// "Eastern Time", or its abbreviation when there is no name for it in locale
+func PresentZone(t time.Time, locale string) string {
+	names, ok := zoneNames[locale]
+	if !ok {
+		names = zoneNames[LocaleEnUS]
+	}
+	if name, ok := names[t.Location().String()]; ok {
+		return name
+	}
+	abbr, _ := t.Zone()
+	return abbr
+}
@@ -0,0 +1,134 @@
+package natural
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLocation(t *testing.T) {
+	expected := map[string]string{
+		// input, location name
+		"Europe/Stockholm": "Europe/Stockholm",
+		"europe/stockholm": "Europe/Stockholm",
+		"america/new_york": "America/New_York",
+		"svensk tid":       "Europe/Stockholm",
+		"Swedish time":     "Europe/Stockholm",
+		"östkusttid":       "America/New_York",
+		"Pacific Time":     "America/Los_Angeles",
+		"GMT":              "UTC",
+		"utc":              "UTC",
+		"CET":              "CET",
+		"pst":              "PST",
+		"UTC+2":            "UTC+2",
+		"GMT-05:30":        "UTC-5:30",
+		"+02:00":           "UTC+2",
+		"utc−3":            "UTC-3",
+	}
+	for in, name := range expected {
+		loc, err := ParseLocation(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, name, loc.String(), in)
+	}
+
+	offsets := map[string]int{
+		"CET":       1 * 60 * 60,
+		"CEST":      2 * 60 * 60,
+		"EST":       -5 * 60 * 60,
+		"PDT":       -7 * 60 * 60,
+		"UTC+2":     2 * 60 * 60,
+		"GMT-05:30": -(5*60 + 30) * 60,
+	}
+	for in, offset := range offsets {
+		loc, _ := ParseLocation(in)
+		_, got := time.Date(2024, time.January, 1, 12, 0, 0, 0, loc).Zone()
+		assert.Equal(t, offset, got, in)
+	}
+
+	for _, in := range []string{"", "imorgon", "-05", "UTC+15", "Europe/Nowhere"} {
+		_, err := ParseLocation(in)
+		assert.NotEqual(t, nil, err, in)
+	}
+}
+
+func TestParseTimeWithZone(t *testing.T) {
+	stockholm, _ := time.LoadLocation("Europe/Stockholm")
+	newYork, _ := time.LoadLocation("America/New_York")
+	p := testParser(LocaleSvSE)
+
+	expected := map[string]time.Time{
+		// input, expected
+		"kl 15 svensk tid":       time.Date(2024, time.April, 17, 15, 0, 0, 0, stockholm),
+		"3pm EST":                time.Date(2024, time.April, 17, 15, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+		"9:30 UTC+2":             time.Date(2024, time.April, 17, 9, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+		"kl 8 eastern time":      time.Date(2024, time.April, 17, 8, 0, 0, 0, newYork),
+		"kl 12 america/new_york": time.Date(2024, time.April, 17, 12, 0, 0, 0, newYork),
+		"18:00 GMT":              time.Date(2024, time.April, 17, 18, 0, 0, 0, time.UTC),
+		"3 maj kl 9 (CET)":       time.Date(2024, time.May, 3, 9, 0, 0, 0, time.FixedZone("CET", 60*60)),
+	}
+	for in, exp := range expected {
+		res, err := p.ParseTime(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp.String(), res.String(), in)
+	}
+
+	// the date is the date in the given zone, 15:30 UTC is 00:30 in Tokyo
+	res, err := p.ParseTime("idag Asia/Tokyo")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "2024-04-18 00:00:00 +0900 JST", res.String())
+}
+
+func TestParserLocation(t *testing.T) {
+	stockholm, _ := time.LoadLocation("Europe/Stockholm")
+	p := testParser(LocaleSvSE)
+	p.Location = stockholm
+
+	res, err := p.ParseTime("kl 9")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, time.Date(2024, time.April, 17, 9, 0, 0, 0, stockholm).String(), res.String())
+
+	res, err = p.ParseTime("kl 9 UTC")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, time.Date(2024, time.April, 17, 9, 0, 0, 0, time.UTC).String(), res.String())
+}
+
+func TestParseTimeDaylightSavingGap(t *testing.T) {
+	stockholm, _ := time.LoadLocation("Europe/Stockholm")
+	p := testParser(LocaleSvSE)
+	p.Location = stockholm
+
+	// clocks go from 02:00 to 03:00 on March 31st 2024
+	expected := map[string]string{
+		"31 mars 2024 kl 1:30":    "2024-03-31 01:30:00 +0100 CET",
+		"31 mars 2024 kl 2:30":    "2024-03-31 03:30:00 +0200 CEST",
+		"31 mars 2024 kl 3:30":    "2024-03-31 03:30:00 +0200 CEST",
+		"31 mars 2024 kl 12":      "2024-03-31 12:00:00 +0200 CEST",
+		"27 oktober 2024 kl 1:30": "2024-10-27 01:30:00 +0200 CEST",
+	}
+	for in, exp := range expected {
+		res, err := p.ParseTime(in)
+		assert.Equal(t, nil, err, in)
+		assert.Equal(t, exp, res.String(), in)
+	}
+
+	gap := time.Date(2024, time.March, 31, 0, 30, 0, 0, stockholm)
+	assert.Equal(t, "2024-03-31 03:30:00 +0200 CEST", setHour(gap, 2).String())
+}
+
+func TestPresentZone(t *testing.T) {
+	stockholm, _ := time.LoadLocation("Europe/Stockholm")
+	newYork, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	tm := time.Date(2024, time.April, 17, 15, 0, 0, 0, stockholm)
+
+	assert.Equal(t, "svensk tid", PresentZone(tm, LocaleSvSE))
+	assert.Equal(t, "Swedish time", PresentZone(tm, LocaleEnUS))
+	assert.Equal(t, "östkusttid", PresentZone(tm.In(newYork), LocaleSvSE))
+	assert.Equal(t, "Eastern Time", PresentZone(tm.In(newYork), LocaleEnUS))
+	assert.Equal(t, "JST", PresentZone(tm.In(tokyo), LocaleSvSE))
+	assert.Equal(t, "UTC+5:30", PresentZone(tm.In(time.FixedZone("UTC+5:30", 19800)), LocaleEnUS))
+
+	assert.Equal(t, "femton svensk tid", PresentClock(tm, LocaleSvSE, Clock24h|ClockWithZone))
+	assert.Equal(t, "nine o'clock Eastern Time", PresentClock(tm.In(newYork), LocaleEnUS, ClockWithZone))
+}